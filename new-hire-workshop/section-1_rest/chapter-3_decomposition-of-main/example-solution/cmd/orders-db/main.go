@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/migrate"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/postgres"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlite"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlstore"
+)
+
+const (
+	ErrLoggerInit    = 1
+	ErrInvalidArgs   = 2
+	ErrDatabaseInit  = 3
+	ErrCommandFailed = 4
+)
+
+const usage = `Usage: orders-db [-driver <postgres|sqlite>] [-dsn <dsn>] <command>
+
+Manages the schema and data of the orders database.
+
+Commands:
+  up       Apply all pending migrations
+  down     Roll back the most recent migration
+  clean    Roll back all migrations, removing all tables and data
+  seed     Load the sample orders into the database
+  version  Print the current schema version
+
+Flags:
+`
+
+func run() int {
+	logger, err := logging.NewZapJSONLogger("info")
+	if err != nil {
+		// If we fail to init the logger, we log out the error in a non-structured manner as it's
+		// better to have the error message, even if it's not in the right format
+		fmt.Printf("failed to init logger: %s", err.Error())
+		return ErrLoggerInit
+	}
+
+	flags := flag.NewFlagSet("orders-db", flag.ContinueOnError)
+	driver := flags.String("driver", "postgres", "type of database to manage: postgres or sqlite")
+	dsn := flags.String("dsn", os.Getenv("ORDERS_DB_DSN"), "postgres connection string or sqlite file path (defaults to $ORDERS_DB_DSN)")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		return ErrInvalidArgs
+	}
+
+	if flags.NArg() != 1 || *dsn == "" {
+		flags.Usage()
+		return ErrInvalidArgs
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	db, migrator, err := openDatabase(ctx, *driver, *dsn)
+	if err != nil {
+		logger.WithError(err).WithField("driver", *driver).Error("failed to open database")
+		return ErrDatabaseInit
+	}
+	defer db.Close()
+
+	command := flags.Arg(0)
+	switch command {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		err = migrator.Down(ctx)
+	case "clean":
+		err = migrator.Reset(ctx)
+	case "seed":
+		err = seed(ctx, sqlstore.NewOrderStore(db), logger)
+	case "version":
+		var version int
+		version, err = migrator.Version(ctx)
+		if err == nil {
+			fmt.Println(version)
+		}
+	default:
+		flags.Usage()
+		return ErrInvalidArgs
+	}
+
+	if err != nil {
+		logger.WithError(err).WithField("command", command).Error("command failed")
+		return ErrCommandFailed
+	}
+
+	logger.WithField("command", command).Info("command completed")
+	return 0
+}
+
+func openDatabase(ctx context.Context, driver, dsn string) (*sql.DB, *migrate.Migrator, error) {
+	var db *sql.DB
+	var newMigrator func(*sql.DB) (*migrate.Migrator, error)
+	var err error
+
+	switch driver {
+	case "postgres":
+		db, err = postgres.Open(ctx, dsn)
+		newMigrator = postgres.NewMigrator
+	case "sqlite":
+		db, err = sqlite.Open(ctx, dsn)
+		newMigrator = sqlite.NewMigrator
+	default:
+		return nil, nil, fmt.Errorf("unknown driver %q", driver)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("loading migrations: %w", err)
+	}
+
+	return db, migrator, nil
+}
+
+// seed loads the sample orders into the store. Orders that already exist are skipped, so the
+// database can be seeded more than once.
+func seed(ctx context.Context, store orders.OrderRepository, logger logging.Logger) error {
+	for _, order := range orders.SampleOrders() {
+		err := store.CreateOrder(ctx, order)
+		if errors.As(err, &terrors.StateConflictType) {
+			logger.WithField("order-id", order.OrderID).Info("order already exists, skipping")
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	if retCode := run(); retCode != 0 {
+		os.Exit(retCode)
+	}
+}
@@ -2,22 +2,335 @@ package main
 
 import (
 	"context"
-	"example-solution/internal/orders"
-	"example-solution/internal/rest"
+	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/gorilla/mux"
 	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/events"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/postgres"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlite"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlstore"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
 )
 
 const (
 	ErrLoggerInit         = 1
 	ErrServerInit         = 2
 	ErrShuttingDownServer = 3
+	ErrInvalidConfig      = 4
 )
 
+// maxWebhookRetryDelay is the longest wait between retries of a webhook delivery, however many
+// times it has failed.
+const maxWebhookRetryDelay = time.Hour
+
+const (
+	StorageDriverMemory   = "memory"
+	StorageDriverPostgres = "postgres"
+	StorageDriverSQLite   = "sqlite"
+)
+
+type config struct {
+	// storageDriver selects where orders are kept: in memory, in PostgreSQL or in a SQLite file.
+	storageDriver string
+	// storageDSN is the PostgreSQL connection string or the path to the SQLite file, depending on
+	// the storage driver. It is not used by the memory driver.
+	storageDSN string
+	// apiSpecPath is the path to an OpenAPI spec to validate requests against and serve to
+	// consumers instead of the one built into the server. It is only needed while working on the
+	// spec, before the code has been regenerated from it.
+	apiSpecPath string
+	// idempotencyTTL is how long the response to a request with an idempotency key is kept for
+	// retries of the request.
+	idempotencyTTL time.Duration
+	// webhookMaxAttempts is how many times a webhook delivery is tried before it is dead-lettered.
+	webhookMaxAttempts int
+	// webhookRetryDelay is how long to wait before the first retry of a webhook delivery. The wait
+	// doubles with each retry after that, up to an hour.
+	webhookRetryDelay time.Duration
+	// jwks is the path or URL of the JSON Web Key Set with the keys that bearer tokens are signed
+	// with.
+	jwks string
+	// jwtIssuer and jwtAudience are the iss and aud claims that bearer tokens must have.
+	jwtIssuer   string
+	jwtAudience string
+	// rateLimitClient limits the requests from each IP address, rateLimitAPIKey the requests with
+	// each API key and rateLimitUser the requests from each user. rateLimitOperations further
+	// limits each user's requests to particular operations.
+	rateLimitClient     ratelimit.Limit
+	rateLimitAPIKey     ratelimit.Limit
+	rateLimitUser       ratelimit.Limit
+	rateLimitOperations map[string]ratelimit.Limit
+	// warehouseDelay is how long the simulated warehouse takes to send out each order, and then to
+	// deliver it. The warehouse is not simulated if it is 0.
+	warehouseDelay time.Duration
+	// devUserIDAuth takes the user from the userid query parameter instead of a bearer token, for
+	// development without an identity provider. Anyone can act as any user, so it must never be
+	// used in production.
+	devUserIDAuth bool
+	// logLevel is the least severe level of the messages that are logged.
+	logLevel string
+	// debug turns on checking of every response against the API spec, logging any that do not
+	// match. It is too slow to leave on in production.
+	debug bool
+}
+
+// loadConfig reads the configuration from the command line flags, falling back to environment
+// variables so the server can be configured in a container without changing its command.
+func loadConfig(args []string) (config, error) {
+	cfg := config{}
+
+	// The defaults for the duration and number flags are read from the environment separately, as
+	// they have to be parsed first.
+	idempotencyTTL := 24 * time.Hour
+	if ttl, ok := os.LookupEnv("IDEMPOTENCY_TTL"); ok {
+		parsed, err := time.ParseDuration(ttl)
+		if err != nil {
+			return config{}, fmt.Errorf("invalid IDEMPOTENCY_TTL: %w", err)
+		}
+		idempotencyTTL = parsed
+	}
+
+	webhookMaxAttempts := 8
+	if attempts, ok := os.LookupEnv("WEBHOOK_MAX_ATTEMPTS"); ok {
+		parsed, err := strconv.Atoi(attempts)
+		if err != nil {
+			return config{}, fmt.Errorf("invalid WEBHOOK_MAX_ATTEMPTS: %w", err)
+		}
+		webhookMaxAttempts = parsed
+	}
+
+	webhookRetryDelay := 30 * time.Second
+	if delay, ok := os.LookupEnv("WEBHOOK_RETRY_DELAY"); ok {
+		parsed, err := time.ParseDuration(delay)
+		if err != nil {
+			return config{}, fmt.Errorf("invalid WEBHOOK_RETRY_DELAY: %w", err)
+		}
+		webhookRetryDelay = parsed
+	}
+
+	var warehouseDelay time.Duration
+	if delay, ok := os.LookupEnv("SIMULATE_WAREHOUSE"); ok {
+		parsed, err := time.ParseDuration(delay)
+		if err != nil {
+			return config{}, fmt.Errorf("invalid SIMULATE_WAREHOUSE: %w", err)
+		}
+		warehouseDelay = parsed
+	}
+
+	var rateLimitClient, rateLimitAPIKey, rateLimitUser, rateLimitOperations string
+	flags := flag.NewFlagSet("rest-server", flag.ContinueOnError)
+	flags.StringVar(
+		&cfg.storageDriver,
+		"storage-driver",
+		envOrDefault("STORAGE_DRIVER", StorageDriverMemory),
+		"where to store orders: memory, postgres or sqlite (defaults to $STORAGE_DRIVER)",
+	)
+	flags.StringVar(
+		&cfg.storageDSN,
+		"storage-dsn",
+		os.Getenv("STORAGE_DSN"),
+		"postgres connection string or sqlite file path (defaults to $STORAGE_DSN)",
+	)
+	flags.StringVar(
+		&cfg.apiSpecPath,
+		"api-spec",
+		os.Getenv("API_SPEC"),
+		"path to an OpenAPI spec to use instead of the built in one (defaults to $API_SPEC)",
+	)
+	flags.DurationVar(
+		&cfg.idempotencyTTL,
+		"idempotency-ttl",
+		idempotencyTTL,
+		"how long to keep responses for retries with the same idempotency key (defaults to $IDEMPOTENCY_TTL)",
+	)
+	flags.IntVar(
+		&cfg.webhookMaxAttempts,
+		"webhook-max-attempts",
+		webhookMaxAttempts,
+		"how many times to try a webhook delivery before dead-lettering it (defaults to $WEBHOOK_MAX_ATTEMPTS)",
+	)
+	flags.DurationVar(
+		&cfg.webhookRetryDelay,
+		"webhook-retry-delay",
+		webhookRetryDelay,
+		"how long to wait before first retrying a webhook delivery (defaults to $WEBHOOK_RETRY_DELAY)",
+	)
+	flags.StringVar(
+		&cfg.jwks,
+		"jwks",
+		os.Getenv("JWKS"),
+		"path or URL of the JWKS that bearer tokens are signed with (defaults to $JWKS)",
+	)
+	flags.StringVar(
+		&cfg.jwtIssuer,
+		"jwt-issuer",
+		os.Getenv("JWT_ISSUER"),
+		"the issuer that bearer tokens must be from (defaults to $JWT_ISSUER)",
+	)
+	flags.StringVar(
+		&cfg.jwtAudience,
+		"jwt-audience",
+		os.Getenv("JWT_AUDIENCE"),
+		"the audience that bearer tokens must be for (defaults to $JWT_AUDIENCE)",
+	)
+	flags.StringVar(
+		&rateLimitClient,
+		"rate-limit-client",
+		envOrDefault("RATE_LIMIT_CLIENT", "600/1m"),
+		"how many requests each IP address can make, as requests/window (defaults to $RATE_LIMIT_CLIENT)",
+	)
+	flags.StringVar(
+		&rateLimitAPIKey,
+		"rate-limit-api-key",
+		envOrDefault("RATE_LIMIT_API_KEY", "600/1m"),
+		"how many requests can be made with each API key, as requests/window (defaults to $RATE_LIMIT_API_KEY)",
+	)
+	flags.StringVar(
+		&rateLimitUser,
+		"rate-limit-user",
+		envOrDefault("RATE_LIMIT_USER", "300/1m"),
+		"how many requests each user can make, as requests/window (defaults to $RATE_LIMIT_USER)",
+	)
+	flags.StringVar(
+		&rateLimitOperations,
+		"rate-limit-operations",
+		os.Getenv("RATE_LIMIT_OPERATIONS"),
+		"further limits on each user's requests to operations, such as PlaceOrderV2=10/1m,CancelOrderV2=10/1m (defaults to $RATE_LIMIT_OPERATIONS)",
+	)
+	flags.DurationVar(
+		&cfg.warehouseDelay,
+		"simulate-warehouse",
+		warehouseDelay,
+		"send out and deliver orders this long after they are placed, to simulate a warehouse, or 0 not to (defaults to $SIMULATE_WAREHOUSE)",
+	)
+	flags.BoolVar(
+		&cfg.devUserIDAuth,
+		"dev-userid-auth",
+		os.Getenv("DEV_USERID_AUTH") == "true",
+		"trust the userid query parameter instead of requiring bearer tokens, for development only (defaults to $DEV_USERID_AUTH)",
+	)
+	flags.StringVar(
+		&cfg.logLevel,
+		"log-level",
+		envOrDefault("LOG_LEVEL", "info"),
+		"the least severe level of messages to log: debug, info, warn or error (defaults to $LOG_LEVEL)",
+	)
+	flags.BoolVar(
+		&cfg.debug,
+		"debug",
+		os.Getenv("DEBUG") == "true",
+		"log responses that do not match the API spec (defaults to $DEBUG)",
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	var err error
+	if cfg.rateLimitClient, err = ratelimit.ParseLimit(rateLimitClient); err != nil {
+		return config{}, err
+	}
+
+	if cfg.rateLimitAPIKey, err = ratelimit.ParseLimit(rateLimitAPIKey); err != nil {
+		return config{}, err
+	}
+
+	if cfg.rateLimitUser, err = ratelimit.ParseLimit(rateLimitUser); err != nil {
+		return config{}, err
+	}
+
+	if cfg.rateLimitOperations, err = parseOperationRateLimits(rateLimitOperations); err != nil {
+		return config{}, err
+	}
+
+	if cfg.idempotencyTTL <= 0 {
+		return config{}, errors.New("the idempotency TTL must be positive")
+	}
+
+	if cfg.webhookMaxAttempts < 1 {
+		return config{}, errors.New("webhook deliveries must be tried at least once")
+	}
+
+	if cfg.webhookRetryDelay <= 0 || cfg.webhookRetryDelay > maxWebhookRetryDelay {
+		return config{}, fmt.Errorf("the webhook retry delay must be positive and at most %s", maxWebhookRetryDelay)
+	}
+
+	if cfg.warehouseDelay < 0 {
+		return config{}, errors.New("the simulated warehouse delay must not be negative")
+	}
+
+	switch {
+	case cfg.devUserIDAuth && cfg.jwks != "":
+		return config{}, errors.New("bearer tokens are not used with userid authentication, so a JWKS must not be given")
+	case !cfg.devUserIDAuth && (cfg.jwks == "" || cfg.jwtIssuer == "" || cfg.jwtAudience == ""):
+		return config{}, errors.New("a JWKS, issuer and audience must be given to authenticate bearer tokens, unless userid authentication is used for development")
+	}
+
+	switch cfg.storageDriver {
+	case StorageDriverMemory:
+	case StorageDriverPostgres, StorageDriverSQLite:
+		if cfg.storageDSN == "" {
+			return config{}, fmt.Errorf("a storage DSN must be provided for the %s storage driver", cfg.storageDriver)
+		}
+	default:
+		return config{}, fmt.Errorf("unknown storage driver %q", cfg.storageDriver)
+	}
+
+	return cfg, nil
+}
+
+// parseOperationRateLimits parses a comma separated list of operation IDs and the limits on them,
+// such as PlaceOrderV2=10/1m,CancelOrderV2=10/1m.
+func parseOperationRateLimits(s string) (map[string]ratelimit.Limit, error) {
+	limits := map[string]ratelimit.Limit{}
+	if strings.TrimSpace(s) == "" {
+		return limits, nil
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		operationID, limit, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("operation rate limit %q is not of the form operationId=requests/window", entry)
+		}
+
+		parsed, err := ratelimit.ParseLimit(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		limits[strings.TrimSpace(operationID)] = parsed
+	}
+
+	return limits, nil
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+
+	return defaultValue
+}
+
 func run() int {
 	logger, err := logging.NewZapJSONLogger("info")
 	if err != nil {
@@ -27,8 +340,45 @@ func run() int {
 		return ErrLoggerInit
 	}
 
+	cfg, err := loadConfig(os.Args[1:])
+	if err != nil {
+		logger.WithError(err).Error("invalid configuration")
+		return ErrInvalidConfig
+	}
+
+	// The configuration is read with a logger at the default level, as it is what sets the level,
+	// so the logger is replaced with one at the configured level once it has been read.
+	configuredLogger, err := logging.NewZapJSONLogger(cfg.logLevel)
+	if err != nil {
+		logger.WithError(err).WithField("log-level", cfg.logLevel).Error("invalid configuration")
+		return ErrInvalidConfig
+	}
+	logger = configuredLogger
+
 	baseCtx := context.Background()
-	server, err := makeServer(baseCtx, logger)
+	repos, closer, err := makeRepositories(baseCtx, cfg)
+	if err != nil {
+		logger.WithError(err).WithField("storage-driver", cfg.storageDriver).Error("failed to create stores")
+		return ErrServerInit
+	}
+	defer func() {
+		if err := closer.Close(); err != nil {
+			logger.WithError(err).Error("failed to close stores")
+		}
+	}()
+
+	webhookService := webhooks.NewService(repos.webhooks, rest.EncodeWebhookEvent, webhooks.RetryPolicy{
+		MaxAttempts: cfg.webhookMaxAttempts,
+		BaseDelay:   cfg.webhookRetryDelay,
+		MaxDelay:    maxWebhookRetryDelay,
+	}, logger)
+
+	var warehouse *orders.Warehouse
+	if cfg.warehouseDelay > 0 {
+		warehouse = orders.NewWarehouse(cfg.warehouseDelay, logger)
+	}
+
+	server, orderService, err := makeServer(baseCtx, cfg, repos, webhookService, warehouse, logger)
 	if err != nil {
 		logger.WithError(err).Error("failed to create server")
 		return ErrServerInit
@@ -42,9 +392,29 @@ func run() int {
 	// a metrics server)
 	go server.Start()
 
+	// The webhook deliveries are sent in the background until shutdown, which waits for them to stop
+	// before the stores are closed.
+	webhooksDone := make(chan struct{})
+	go func() {
+		defer close(webhooksDone)
+		webhookService.Run(ctx)
+	}()
+
+	// Likewise, the simulated warehouse moves orders until shutdown.
+	warehouseDone := make(chan struct{})
+	go func() {
+		defer close(warehouseDone)
+		if warehouse != nil {
+			warehouse.Run(ctx, orderService)
+		}
+	}()
+
 	<-ctx.Done()
 
-	if err := server.Stop(); err != nil {
+	err = server.Stop()
+	<-webhooksDone
+	<-warehouseDone
+	if err != nil {
 		logger.WithError(err).Error("error shutting down rest server")
 		return ErrShuttingDownServer
 	}
@@ -52,12 +422,169 @@ func run() int {
 	return 0
 }
 
-func makeServer(baseCtx context.Context, logger logging.Logger) (*rest.Server, error) {
-	orderStore := orders.NewOrderStore()
+// repositories holds the stores that the server keeps its data in.
+type repositories struct {
+	orders          orders.OrderRepository
+	idempotencyKeys idempotency.KeyRepository
+	webhooks        webhooks.Repository
+	rateLimits      ratelimit.BucketRepository
+}
+
+// makeRepositories creates the stores selected by the config. The returned closer releases any
+// database connections, and must be called once the stores are no longer in use.
+func makeRepositories(ctx context.Context, cfg config) (repositories, io.Closer, error) {
+	switch cfg.storageDriver {
+	case StorageDriverPostgres:
+		// The PostgreSQL schema is managed separately with the orders-db command, as in a real
+		// deployment the server would not have permission to change it.
+		db, err := postgres.Open(ctx, cfg.storageDSN)
+		if err != nil {
+			return repositories{}, nil, err
+		}
+
+		return sqlRepositories(db), db, nil
+	case StorageDriverSQLite:
+		db, err := sqlite.Open(ctx, cfg.storageDSN)
+		if err != nil {
+			return repositories{}, nil, err
+		}
+
+		// A SQLite file is only ever used locally, so the schema is brought up to date on start up
+		// to save developers from having to run the migrations by hand.
+		if err := migrateSQLite(ctx, db); err != nil {
+			db.Close()
+			return repositories{}, nil, err
+		}
+
+		return sqlRepositories(db), db, nil
+	default:
+		return repositories{
+			orders:          orders.NewOrderStore(),
+			idempotencyKeys: idempotency.NewKeyStore(),
+			webhooks:        webhooks.NewStore(),
+			rateLimits:      ratelimit.NewBucketStore(),
+		}, closerFunc(func() error { return nil }), nil
+	}
+}
+
+func sqlRepositories(db *sql.DB) repositories {
+	return repositories{
+		orders:          sqlstore.NewOrderStore(db),
+		idempotencyKeys: sqlstore.NewIdempotencyKeyStore(db),
+		webhooks:        sqlstore.NewWebhookStore(db),
+		rateLimits:      sqlstore.NewRateLimitStore(db),
+	}
+}
+
+// closerFunc adapts a plain function to the io.Closer interface.
+type closerFunc func() error
 
-	router := rest.NewMux(orderStore, logger)
+func (f closerFunc) Close() error {
+	return f()
+}
+
+func migrateSQLite(ctx context.Context, db *sql.DB) error {
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	return migrator.Up(ctx)
+}
+
+func makeServer(
+	baseCtx context.Context,
+	cfg config,
+	repos repositories,
+	webhookService *webhooks.Service,
+	warehouse *orders.Warehouse,
+	logger logging.Logger,
+) (*rest.Server, *orders.Service, error) {
+	specYAML := api.Spec
+	if cfg.apiSpecPath != "" {
+		var err error
+		if specYAML, err = os.ReadFile(cfg.apiSpecPath); err != nil {
+			return nil, nil, fmt.Errorf("reading API spec: %w", err)
+		}
+	}
+
+	spec, err := rest.ParseSpec(baseCtx, specYAML)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validator, err := rest.NewRequestValidator(spec, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docs, err := rest.NewDocs(specYAML, spec, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var middlewares []mux.MiddlewareFunc
+	if cfg.debug {
+		responseValidator, err := rest.NewResponseValidator(spec, func(r *http.Request, err error) {
+			logctx.Logger(r.Context(), logger).WithError(err).Warn("response does not match the API spec")
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		middlewares = append(middlewares, responseValidator.Middleware)
+	}
+
+	rateLimiter, err := rest.NewRateLimiter(repos.rateLimits, rest.RateLimits{
+		Client:     cfg.rateLimitClient,
+		APIKey:     cfg.rateLimitAPIKey,
+		User:       cfg.rateLimitUser,
+		Operations: cfg.rateLimitOperations,
+	}, spec, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authenticator, err := makeAuthenticator(baseCtx, cfg, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Every change to an order goes through the feed's repository, so that the event streams see it.
+	feed := orders.NewFeed()
+	orderStore := orders.NewFeedRepository(repos.orders, feed)
+
+	publishers := []orders.EventPublisher{events.NewLogPublisher(logger), webhookService}
+	if warehouse != nil {
+		publishers = append(publishers, warehouse)
+	}
+
+	publisher := events.NewMultiPublisher(publishers...)
+	orderService := orders.NewService(orderStore, publisher, logger)
+	idempotencyKeys := rest.NewIdempotency(repos.idempotencyKeys, cfg.idempotencyTTL, logger)
+	policy := authz.NewPolicy(authz.NewLogAuditLog(logger))
+	router := rest.NewMux(orderStore, orderService, feed, webhookService, policy, validator, rateLimiter, authenticator, idempotencyKeys, docs, logger, middlewares...)
+
+	server, err := rest.NewServer(baseCtx, 8080, router, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return server, orderService, nil
+}
+
+func makeAuthenticator(ctx context.Context, cfg config, logger logging.Logger) (*rest.Authenticator, error) {
+	if cfg.devUserIDAuth {
+		logger.Warn("trusting the userid query parameter instead of bearer tokens, which is only safe in development")
+		return rest.NewQueryParamAuthenticator(logger), nil
+	}
+
+	keys, err := rest.LoadKeySet(ctx, cfg.jwks, logger)
+	if err != nil {
+		return nil, err
+	}
 
-	return rest.NewServer(baseCtx, 8080, router, logger)
+	return rest.NewTokenAuthenticator(keys, cfg.jwtIssuer, cfg.jwtAudience, logger), nil
 }
 
 // It looks quite strange to have main be such a small bit of code for main, but we
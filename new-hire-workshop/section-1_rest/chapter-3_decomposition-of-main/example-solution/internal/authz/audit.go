@@ -0,0 +1,59 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+// AuditEntry records a privileged access, or an attempt at an action that was denied.
+type AuditEntry struct {
+	At        time.Time
+	Principal Principal
+	Action    Action
+	Resource  Resource
+	Allowed   bool
+}
+
+// AuditLog keeps the audit entries recorded by a Policy.
+type AuditLog interface {
+	Record(ctx context.Context, entry AuditEntry) error
+}
+
+// LogAuditLog records audit entries by logging them. It stands in for a dedicated audit store
+// until the order service is connected to one, in the same way as events.LogPublisher.
+type LogAuditLog struct {
+	logger logging.Logger
+}
+
+var _ AuditLog = (*LogAuditLog)(nil)
+
+func NewLogAuditLog(logger logging.Logger) *LogAuditLog {
+	return &LogAuditLog{
+		logger: logger,
+	}
+}
+
+func (l *LogAuditLog) Record(ctx context.Context, entry AuditEntry) error {
+	logger := logctx.Logger(ctx, l.logger).WithFields(logging.Fields{
+		"audit":              true,
+		"at":                 entry.At,
+		"principal":          entry.Principal.ID,
+		"tenant-id":          entry.Principal.TenantID,
+		"roles":              entry.Principal.Roles,
+		"action":             entry.Action,
+		"resource-tenant-id": entry.Resource.TenantID,
+		"customer-id":        entry.Resource.CustomerID,
+		"resource-id":        entry.Resource.ID,
+	})
+
+	if entry.Allowed {
+		logger.Info("privileged access allowed")
+	} else {
+		logger.Warn("access denied")
+	}
+
+	return nil
+}
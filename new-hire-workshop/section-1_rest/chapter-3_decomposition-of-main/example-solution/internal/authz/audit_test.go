@@ -0,0 +1,55 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logtest"
+)
+
+func TestLogAuditLog(t *testing.T) {
+	support := authz.Principal{ID: "support", TenantID: "tenant", Roles: []authz.Role{authz.RoleSupport}}
+	order := authz.Resource{TenantID: "tenant", CustomerID: "customer", ID: "order"}
+
+	tests := []struct {
+		name    string
+		action  authz.Action
+		allowed bool
+		level   string
+		message string
+	}{
+		{name: "privileged access", action: authz.ActionReadOrders, allowed: true, level: "info", message: "privileged access allowed"},
+		{name: "denied access", action: authz.ActionSetOrderStatus, allowed: false, level: "warn", message: "access denied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logtest.NewLogger()
+			err := authz.NewLogAuditLog(logger).Record(context.Background(), authz.AuditEntry{
+				At:        time.Now(),
+				Principal: support,
+				Action:    tt.action,
+				Resource:  order,
+				Allowed:   tt.allowed,
+			})
+			if err != nil {
+				t.Fatalf("Record returned error: %v", err)
+			}
+
+			entries := logger.Entries()
+			if len(entries) != 1 {
+				t.Fatalf("logged %d entries, want 1", len(entries))
+			}
+
+			entry := entries[0]
+			if entry.Level != tt.level || entry.Message != tt.message {
+				t.Errorf("logged %s %q, want %s %q", entry.Level, entry.Message, tt.level, tt.message)
+			}
+			if entry.Fields["audit"] != true || entry.Fields["principal"] != support.ID || entry.Fields["action"] != tt.action || entry.Fields["resource-id"] != order.ID {
+				t.Errorf("logged fields %v, want the audit entry's principal, action and resource", entry.Fields)
+			}
+		})
+	}
+}
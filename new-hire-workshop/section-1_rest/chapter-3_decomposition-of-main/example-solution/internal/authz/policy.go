@@ -0,0 +1,172 @@
+// Package authz decides what each user may do with orders, based on the roles they are given by
+// the identity provider.
+package authz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+type Role string
+
+const (
+	// RoleCustomer can place orders, and look after their own orders and webhooks.
+	RoleCustomer Role = "customer"
+	// RoleSupport is for support staff, who can read any customer's orders and put them on hold.
+	RoleSupport Role = "support"
+	// RoleAdmin can do anything support staff can, and can also move orders to any status.
+	RoleAdmin Role = "admin"
+)
+
+// ParseRole returns the role with the name, and false if there is no such role.
+func ParseRole(name string) (Role, bool) {
+	switch role := Role(name); role {
+	case RoleCustomer, RoleSupport, RoleAdmin:
+		return role, true
+	}
+
+	return "", false
+}
+
+// Principal is the user that a request is from.
+type Principal struct {
+	// ID is the subject of the user's token. For a customer it is also their customer ID.
+	ID string
+	// TenantID is the tenant the user belongs to. Users can only act within their own tenant,
+	// whatever their roles.
+	TenantID string
+	Roles    []Role
+}
+
+func (p Principal) HasRole(role Role) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Action string
+
+const (
+	// ActionReadOrders is listing and getting orders, and following their events.
+	ActionReadOrders     Action = "orders.read"
+	ActionPlaceOrder     Action = "orders.place"
+	ActionCancelOrder    Action = "orders.cancel"
+	ActionHoldOrder      Action = "orders.hold"
+	ActionSetOrderStatus Action = "orders.set_status"
+	ActionManageWebhooks Action = "webhooks.manage"
+)
+
+// scope is whose resources a role may take an action on.
+type scope int
+
+const (
+	scopeOwn scope = iota + 1
+	scopeAnyCustomer
+)
+
+// grants lists the actions that each role may take. An action that is not listed for a role is
+// denied to it.
+var grants = map[Role]map[Action]scope{
+	RoleCustomer: {
+		ActionReadOrders:     scopeOwn,
+		ActionPlaceOrder:     scopeOwn,
+		ActionCancelOrder:    scopeOwn,
+		ActionManageWebhooks: scopeOwn,
+	},
+	RoleSupport: {
+		ActionReadOrders: scopeAnyCustomer,
+		ActionHoldOrder:  scopeAnyCustomer,
+	},
+	RoleAdmin: {
+		ActionReadOrders:     scopeAnyCustomer,
+		ActionHoldOrder:      scopeAnyCustomer,
+		ActionSetOrderStatus: scopeAnyCustomer,
+	},
+}
+
+// Resource is what an action is taken on.
+type Resource struct {
+	// TenantID and CustomerID are the tenant and customer that the resource belongs to.
+	TenantID   string
+	CustomerID string
+	// ID identifies the resource, such as an order ID. It is empty for an action on all of the
+	// customer's resources, such as listing their orders.
+	ID string
+}
+
+// Policy authorizes users' actions and audits every privileged or denied access.
+type Policy struct {
+	audit AuditLog
+}
+
+func NewPolicy(audit AuditLog) *Policy {
+	return &Policy{
+		audit: audit,
+	}
+}
+
+// Authorize returns a terrors.Forbidden error if the principal may not take the action on the
+// resource. It fails closed if the access cannot be audited.
+func (p *Policy) Authorize(ctx context.Context, principal Principal, action Action, resource Resource) error {
+	sameTenant := resource.TenantID == principal.TenantID
+	ownResource := sameTenant && resource.CustomerID == principal.ID
+	granted := grantedScope(principal, action)
+	allowed := sameTenant && (granted == scopeAnyCustomer || (granted == scopeOwn && ownResource))
+	privileged := allowed && !(ownResource && hasGrant(principal, action, scopeOwn))
+
+	if privileged || !allowed {
+		err := p.audit.Record(ctx, AuditEntry{
+			At:        time.Now(),
+			Principal: principal,
+			Action:    action,
+			Resource:  resource,
+			Allowed:   allowed,
+		})
+		if err != nil {
+			return fmt.Errorf("recording %s by %s in the audit log: %w", action, principal.ID, err)
+		}
+	}
+
+	if !allowed {
+		msg := fmt.Sprintf("the user's roles do not allow %s", action)
+		return terrors.Presentable(terrors.NewForbidden(msg, nil))
+	}
+
+	return nil
+}
+
+// AllowsAnyCustomer reports whether the principal may take the action on any of their tenant's
+// customers' resources, rather than only on their own.
+func (p *Policy) AllowsAnyCustomer(principal Principal, action Action) bool {
+	return grantedScope(principal, action) == scopeAnyCustomer
+}
+
+// grantedScope returns the widest scope that any of the principal's roles has for the action, or 0
+// if none of them have it.
+func grantedScope(principal Principal, action Action) scope {
+	var widest scope
+	for _, role := range principal.Roles {
+		if s := grants[role][action]; s > widest {
+			widest = s
+		}
+	}
+
+	return widest
+}
+
+func hasGrant(principal Principal, action Action, s scope) bool {
+	for _, role := range principal.Roles {
+		if grants[role][action] == s {
+			return true
+		}
+	}
+
+	return false
+}
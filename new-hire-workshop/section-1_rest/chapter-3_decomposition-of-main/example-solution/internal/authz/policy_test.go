@@ -0,0 +1,100 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+)
+
+func TestAuthorize(t *testing.T) {
+	customer := authz.Principal{ID: "customer", TenantID: "tenant", Roles: []authz.Role{authz.RoleCustomer}}
+	otherCustomer := authz.Principal{ID: "other-customer", TenantID: "tenant", Roles: []authz.Role{authz.RoleCustomer}}
+	support := authz.Principal{ID: "support", TenantID: "tenant", Roles: []authz.Role{authz.RoleSupport}}
+	admin := authz.Principal{ID: "admin", TenantID: "tenant", Roles: []authz.Role{authz.RoleAdmin}}
+	order := authz.Resource{TenantID: "tenant", CustomerID: customer.ID, ID: "order"}
+
+	inOtherTenant := func(p authz.Principal) authz.Principal {
+		p.TenantID = "other-tenant"
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		principal authz.Principal
+		action    authz.Action
+		allowed   bool
+		audited   bool
+	}{
+		{name: "customer reads own order", principal: customer, action: authz.ActionReadOrders, allowed: true},
+		{name: "customer cancels own order", principal: customer, action: authz.ActionCancelOrder, allowed: true},
+		{name: "customer holds own order", principal: customer, action: authz.ActionHoldOrder, audited: true},
+		{name: "customer sets own order's status", principal: customer, action: authz.ActionSetOrderStatus, audited: true},
+		{name: "customer reads another customer's order", principal: otherCustomer, action: authz.ActionReadOrders, audited: true},
+		{name: "customer cancels another customer's order", principal: otherCustomer, action: authz.ActionCancelOrder, audited: true},
+		{name: "support reads a customer's order", principal: support, action: authz.ActionReadOrders, allowed: true, audited: true},
+		{name: "support holds a customer's order", principal: support, action: authz.ActionHoldOrder, allowed: true, audited: true},
+		{name: "support cancels a customer's order", principal: support, action: authz.ActionCancelOrder, audited: true},
+		{name: "support sets an order's status", principal: support, action: authz.ActionSetOrderStatus, audited: true},
+		{name: "admin reads a customer's order", principal: admin, action: authz.ActionReadOrders, allowed: true, audited: true},
+		{name: "admin sets an order's status", principal: admin, action: authz.ActionSetOrderStatus, allowed: true, audited: true},
+		{name: "customer of the same ID in another tenant", principal: inOtherTenant(customer), action: authz.ActionReadOrders, audited: true},
+		{name: "support in another tenant", principal: inOtherTenant(support), action: authz.ActionReadOrders, audited: true},
+		{name: "admin in another tenant", principal: inOtherTenant(admin), action: authz.ActionSetOrderStatus, audited: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit := &recordingAuditLog{}
+			err := authz.NewPolicy(audit).Authorize(context.Background(), tt.principal, tt.action, order)
+
+			var forbidden *terrors.Forbidden
+			if tt.allowed && err != nil {
+				t.Errorf("Authorize returned error: %v", err)
+			} else if !tt.allowed && !errors.As(err, &forbidden) {
+				t.Errorf("Authorize returned %v, want a terrors.Forbidden", err)
+			}
+
+			if !tt.audited {
+				if len(audit.entries) != 0 {
+					t.Errorf("recorded audit entries %+v, want none", audit.entries)
+				}
+				return
+			}
+
+			if len(audit.entries) != 1 {
+				t.Fatalf("recorded %d audit entries, want 1", len(audit.entries))
+			}
+			entry := audit.entries[0]
+			if entry.Allowed != tt.allowed || entry.Action != tt.action || entry.Principal.ID != tt.principal.ID || entry.Resource != order {
+				t.Errorf("recorded audit entry %+v, want one for %s on %+v allowed %t", entry, tt.action, order, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestAuthorizeDeniesAccessThatCannotBeAudited(t *testing.T) {
+	support := authz.Principal{ID: "support", TenantID: "tenant", Roles: []authz.Role{authz.RoleSupport}}
+	order := authz.Resource{TenantID: "tenant", CustomerID: "customer", ID: "order"}
+
+	audit := &recordingAuditLog{err: errors.New("audit log is down")}
+	if err := authz.NewPolicy(audit).Authorize(context.Background(), support, authz.ActionReadOrders, order); err == nil {
+		t.Error("Authorize allowed a privileged access that could not be audited")
+	}
+}
+
+type recordingAuditLog struct {
+	entries []authz.AuditEntry
+	err     error
+}
+
+func (l *recordingAuditLog) Record(_ context.Context, entry authz.AuditEntry) error {
+	if l.err != nil {
+		return l.err
+	}
+
+	l.entries = append(l.entries, entry)
+	return nil
+}
@@ -0,0 +1,38 @@
+// Package events provides implementations of orders.EventPublisher.
+package events
+
+import (
+	"context"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// LogPublisher publishes events by logging them. It stands in for a message queue until the
+// order service is connected to one.
+type LogPublisher struct {
+	logger logging.Logger
+}
+
+var _ orders.EventPublisher = (*LogPublisher)(nil)
+
+func NewLogPublisher(logger logging.Logger) *LogPublisher {
+	return &LogPublisher{
+		logger: logger,
+	}
+}
+
+func (l *LogPublisher) Publish(ctx context.Context, event orders.Event) error {
+	logctx.Logger(ctx, l.logger).WithFields(logging.Fields{
+		"event-type":  event.Type,
+		"tenant-id":   event.TenantID,
+		"order-id":    event.OrderID,
+		"customer-id": event.CustomerID,
+		"status":      event.Status,
+		"reason":      event.Reason,
+		"occurred-at": event.OccurredAt,
+	}).Info("order event published")
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"context"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// MultiPublisher publishes each event to several publishers, such as the log and the webhooks.
+type MultiPublisher struct {
+	publishers []orders.EventPublisher
+}
+
+var _ orders.EventPublisher = (*MultiPublisher)(nil)
+
+func NewMultiPublisher(publishers ...orders.EventPublisher) *MultiPublisher {
+	return &MultiPublisher{
+		publishers: publishers,
+	}
+}
+
+// Publish publishes the event to every publisher, even if some of them fail, and returns the
+// error from the first one that did.
+func (m *MultiPublisher) Publish(ctx context.Context, event orders.Event) error {
+	var firstErr error
+	for _, publisher := range m.publishers {
+		if err := publisher.Publish(ctx, event); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
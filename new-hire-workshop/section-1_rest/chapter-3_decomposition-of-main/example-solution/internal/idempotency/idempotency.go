@@ -0,0 +1,47 @@
+// Package idempotency keeps track of the idempotency keys that clients send with requests, so that
+// a retried request gets the response to the original one rather than being carried out twice.
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Record is what is kept for an idempotency key. It has no response until the request completes.
+type Record struct {
+	// TenantID and CustomerID scope the key, so that customers cannot see each other's responses
+	// by guessing their keys, even from another tenant.
+	TenantID   string
+	CustomerID string
+	Key        string
+	// Fingerprint identifies the request the key was first used with, so that reusing the key for
+	// a different request can be rejected.
+	Fingerprint string
+	ExpiresAt   time.Time
+
+	// The response to the request, which is only set once the record is completed.
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Completed reports whether the response to the request has been stored.
+func (r Record) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// KeyRepository is the access point for reading and writing idempotency records. Expired records
+// are treated as if they did not exist.
+type KeyRepository interface {
+	// Claim stores a new record for a key, unless the customer already has an unexpired record
+	// for it. It returns the record that is stored for the key, and whether that is the record
+	// that was passed in.
+	Claim(ctx context.Context, record Record) (Record, bool, error)
+
+	// Complete stores the response in the record for a key that was claimed, returning a
+	// terrors.NotFound error if there is no such record.
+	Complete(ctx context.Context, record Record) error
+
+	// Release deletes the record for a key, so that the request can be tried again.
+	Release(ctx context.Context, tenantID, customerID, key string) error
+}
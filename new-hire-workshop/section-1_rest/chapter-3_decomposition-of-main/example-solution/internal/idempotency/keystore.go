@@ -0,0 +1,93 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// KeyStore is an in-memory implementation of KeyRepository. It is safe for concurrent use by
+// multiple goroutines.
+type KeyStore struct {
+	mu      sync.Mutex
+	records map[recordID]Record
+	// nextSweep is when the expired records are next removed.
+	nextSweep time.Time
+}
+
+// sweepInterval is how often a KeyStore removes expired records.
+const sweepInterval = time.Minute
+
+// recordID identifies a record, as keys are scoped to the customer that sent them.
+type recordID struct {
+	tenantID   string
+	customerID string
+	key        string
+}
+
+var _ KeyRepository = (*KeyStore)(nil)
+
+func NewKeyStore() *KeyStore {
+	return &KeyStore{
+		records: map[recordID]Record{},
+	}
+}
+
+func (s *KeyStore) Claim(_ context.Context, record Record) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if !now.Before(s.nextSweep) {
+		s.sweep(now)
+	}
+
+	id := recordID{tenantID: record.TenantID, customerID: record.CustomerID, key: record.Key}
+	if stored, ok := s.records[id]; ok && stored.ExpiresAt.After(now) {
+		return stored.clone(), false, nil
+	}
+
+	s.records[id] = record.clone()
+	return record, true, nil
+}
+
+func (s *KeyStore) Complete(_ context.Context, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := recordID{tenantID: record.TenantID, customerID: record.CustomerID, key: record.Key}
+	if _, ok := s.records[id]; !ok {
+		return terrors.NewNotFound("idempotency key", "key", record.Key, nil)
+	}
+
+	s.records[id] = record.clone()
+	return nil
+}
+
+func (s *KeyStore) Release(_ context.Context, tenantID, customerID, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, recordID{tenantID: tenantID, customerID: customerID, key: key})
+	return nil
+}
+
+// sweep removes the records that have expired at now. The caller must hold the mutex.
+func (s *KeyStore) sweep(now time.Time) {
+	for id, stored := range s.records {
+		if !stored.ExpiresAt.After(now) {
+			delete(s.records, id)
+		}
+	}
+
+	s.nextSweep = now.Add(sweepInterval)
+}
+
+// clone copies the response body, so that a caller changing a record it was given cannot change
+// what is held in the store.
+func (r Record) clone() Record {
+	r.Body = append([]byte(nil), r.Body...)
+	return r
+}
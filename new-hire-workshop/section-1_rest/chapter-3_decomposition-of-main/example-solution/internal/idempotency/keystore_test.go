@@ -0,0 +1,58 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestKeyStoreClaimsExpiredKeys(t *testing.T) {
+	store := NewKeyStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	expired := Record{TenantID: "tenant", CustomerID: "customer", Key: "expired", ExpiresAt: now.Add(-time.Second)}
+	live := Record{TenantID: "tenant", CustomerID: "customer", Key: "live", ExpiresAt: now.Add(time.Hour)}
+	for _, record := range []Record{expired, live} {
+		if _, claimed, err := store.Claim(ctx, record); err != nil || !claimed {
+			t.Fatalf("Claim of new key %s returned claimed %t and error %v, want it claimed", record.Key, claimed, err)
+		}
+	}
+
+	// The expired record is kept until the next sweep, but its key can still be claimed again.
+	expired.ExpiresAt = now.Add(time.Hour)
+	if _, claimed, err := store.Claim(ctx, expired); err != nil || !claimed {
+		t.Errorf("Claim of expired key returned claimed %t and error %v, want it claimed", claimed, err)
+	}
+	if _, claimed, err := store.Claim(ctx, live); err != nil || claimed {
+		t.Errorf("Claim of live key returned claimed %t and error %v, want the stored record", claimed, err)
+	}
+}
+
+func TestKeyStoreSweepsExpiredRecords(t *testing.T) {
+	store := NewKeyStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, key := range []string{"a", "b", "c"} {
+		record := Record{TenantID: "tenant", CustomerID: "customer", Key: key, ExpiresAt: now.Add(-time.Second)}
+		if _, _, err := store.Claim(ctx, record); err != nil {
+			t.Fatalf("Claim returned error: %v", err)
+		}
+	}
+
+	store.mu.Lock()
+	store.nextSweep = time.Time{}
+	store.mu.Unlock()
+
+	record := Record{TenantID: "tenant", CustomerID: "customer", Key: "d", ExpiresAt: now.Add(time.Hour)}
+	if _, _, err := store.Claim(ctx, record); err != nil {
+		t.Fatalf("Claim returned error: %v", err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if len(store.records) != 1 {
+		t.Errorf("store holds %d records after a sweep, want only the live one", len(store.records))
+	}
+}
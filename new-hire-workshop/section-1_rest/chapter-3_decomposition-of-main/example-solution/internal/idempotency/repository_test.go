@@ -0,0 +1,12 @@
+package idempotency_test
+
+import (
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency/repositorytest"
+)
+
+func TestKeyStoreRepository(t *testing.T) {
+	repositorytest.TestKeyRepository(t, idempotency.NewKeyStore())
+}
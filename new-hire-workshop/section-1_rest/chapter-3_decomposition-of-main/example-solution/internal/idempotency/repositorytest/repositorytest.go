@@ -0,0 +1,141 @@
+// Package repositorytest is a suite of tests for the contract of idempotency.KeyRepository, in
+// the same way as the orders package's repositorytest is for orders.OrderRepository.
+package repositorytest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+)
+
+// TestKeyRepository runs the contract tests against the repository, each under a tenant of its own.
+func TestKeyRepository(t *testing.T, repo idempotency.KeyRepository) {
+	t.Run("ClaimAndComplete", func(t *testing.T) { testClaimAndComplete(t, repo) })
+	t.Run("ClaimExpired", func(t *testing.T) { testClaimExpired(t, repo) })
+	t.Run("Release", func(t *testing.T) { testRelease(t, repo) })
+	t.Run("OtherCustomersKey", func(t *testing.T) {
+		testKeyIsolation(t, repo, func(record idempotency.Record) idempotency.Record {
+			record.CustomerID = uuid.NewString()
+			return record
+		})
+	})
+	t.Run("OtherTenantsKey", func(t *testing.T) {
+		testKeyIsolation(t, repo, func(record idempotency.Record) idempotency.Record {
+			record.TenantID = newTenantID()
+			return record
+		})
+	})
+}
+
+func testClaimAndComplete(t *testing.T, repo idempotency.KeyRepository) {
+	ctx := context.Background()
+	record := newRecord()
+	mustClaim(t, repo, record)
+
+	// A retry while the request is in progress gets the record without a response.
+	stored, claimed, err := repo.Claim(ctx, record)
+	if err != nil || claimed {
+		t.Fatalf("Claim of a claimed key returned claimed %t and error %v, want the stored record", claimed, err)
+	}
+	if stored.Completed() || stored.Fingerprint != record.Fingerprint {
+		t.Errorf("Claim of a claimed key returned %+v, want the incomplete record", stored)
+	}
+
+	record.StatusCode = 200
+	record.ContentType = "application/json"
+	record.Body = []byte(`{"orderId": "1"}`)
+	if err := repo.Complete(ctx, record); err != nil {
+		t.Fatalf("Complete returned error: %v", err)
+	}
+
+	stored, claimed, err = repo.Claim(ctx, record)
+	if err != nil || claimed {
+		t.Fatalf("Claim of a completed key returned claimed %t and error %v, want the stored record", claimed, err)
+	}
+	if stored.StatusCode != record.StatusCode || stored.ContentType != record.ContentType || !bytes.Equal(stored.Body, record.Body) {
+		t.Errorf("Claim of a completed key returned %+v, want the stored response", stored)
+	}
+}
+
+func testClaimExpired(t *testing.T, repo idempotency.KeyRepository) {
+	record := newRecord()
+	record.ExpiresAt = time.Now().Add(-time.Second)
+	mustClaim(t, repo, record)
+
+	record.ExpiresAt = time.Now().Add(time.Hour)
+	record.Fingerprint = uuid.NewString()
+	mustClaim(t, repo, record)
+}
+
+func testRelease(t *testing.T, repo idempotency.KeyRepository) {
+	ctx := context.Background()
+	record := newRecord()
+	mustClaim(t, repo, record)
+
+	if err := repo.Release(ctx, record.TenantID, record.CustomerID, record.Key); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+
+	mustClaim(t, repo, record)
+}
+
+// testKeyIsolation checks that the same key sent by someone else, as made by other, is kept apart.
+func testKeyIsolation(t *testing.T, repo idempotency.KeyRepository, other func(idempotency.Record) idempotency.Record) {
+	ctx := context.Background()
+	record := newRecord()
+	mustClaim(t, repo, record)
+	otherRecord := other(record)
+
+	var notFound *terrors.NotFound
+	if err := repo.Complete(ctx, otherRecord); !errors.As(err, &notFound) {
+		t.Errorf("Complete of a key that was only claimed by someone else returned %v, want a terrors.NotFound", err)
+	}
+
+	if err := repo.Release(ctx, otherRecord.TenantID, otherRecord.CustomerID, otherRecord.Key); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+
+	stored, claimed, err := repo.Claim(ctx, record)
+	if err != nil || claimed {
+		t.Fatalf("Claim returned claimed %t and error %v after someone else released the key, want the stored record", claimed, err)
+	}
+	if stored.Completed() {
+		t.Errorf("record was completed by someone else: %+v", stored)
+	}
+
+	mustClaim(t, repo, otherRecord)
+}
+
+func newTenantID() string {
+	return "test-" + uuid.NewString()
+}
+
+func newRecord() idempotency.Record {
+	return idempotency.Record{
+		TenantID:    newTenantID(),
+		CustomerID:  uuid.NewString(),
+		Key:         uuid.NewString(),
+		Fingerprint: uuid.NewString(),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+// mustClaim claims the key of the record, failing the test if it was already claimed.
+func mustClaim(t *testing.T, repo idempotency.KeyRepository, record idempotency.Record) {
+	t.Helper()
+
+	stored, claimed, err := repo.Claim(context.Background(), record)
+	if err != nil {
+		t.Fatalf("Claim returned error: %v", err)
+	}
+
+	if !claimed || stored.Fingerprint != record.Fingerprint {
+		t.Fatalf("Claim returned claimed %t with %+v, want the key claimed", claimed, stored)
+	}
+}
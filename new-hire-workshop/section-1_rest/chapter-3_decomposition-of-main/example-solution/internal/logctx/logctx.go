@@ -0,0 +1,25 @@
+// Package logctx gets the logger for a request from its context, so that everything logged while
+// handling a request carries the request's ID and can be traced back to it.
+package logctx
+
+import (
+	"context"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+)
+
+// Logger returns the logger stored in the context with logging.ContextWithLogger, or the fallback
+// if there is none, as there is not for work that is not done for a request.
+func Logger(ctx context.Context, fallback logging.Logger) logging.Logger {
+	if logger, err := logging.LoggerFromContext(ctx); err == nil {
+		return logger
+	}
+
+	return fallback
+}
+
+// WithFields returns a copy of the context whose logger, as returned by Logger, has the fields
+// added, so that everything logged further on in the request has them.
+func WithFields(ctx context.Context, fallback logging.Logger, fields logging.Fields) context.Context {
+	return logging.ContextWithLogger(ctx, Logger(ctx, fallback).WithFields(fields))
+}
@@ -0,0 +1,86 @@
+// Package logtest provides a logging.Logger that records what is logged, for tests.
+package logtest
+
+import (
+	"sync"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+)
+
+// Entry is a message that was logged, along with the fields of the logger it was logged with.
+type Entry struct {
+	Level   string
+	Message string
+	Fields  logging.Fields
+}
+
+// Logger records every entry logged with it or with the loggers made from it. It is safe for
+// concurrent use by multiple goroutines.
+type Logger struct {
+	fields  logging.Fields
+	entries *entries
+}
+
+type entries struct {
+	mu   sync.Mutex
+	list []Entry
+}
+
+var _ logging.Logger = (*Logger)(nil)
+
+func NewLogger() *Logger {
+	return &Logger{
+		fields:  logging.Fields{},
+		entries: &entries{},
+	}
+}
+
+// Entries returns the entries logged so far.
+func (l *Logger) Entries() []Entry {
+	l.entries.mu.Lock()
+	defer l.entries.mu.Unlock()
+
+	return append([]Entry(nil), l.entries.list...)
+}
+
+func (l *Logger) Error(msg string) { l.log("error", msg) }
+
+func (l *Logger) Warn(msg string) { l.log("warn", msg) }
+
+func (l *Logger) Info(msg string) { l.log("info", msg) }
+
+func (l *Logger) Debug(msg string) { l.log("debug", msg) }
+
+func (l *Logger) WithField(key string, value interface{}) logging.Logger {
+	return l.WithFields(logging.Fields{key: value})
+}
+
+func (l *Logger) WithError(err error) logging.Logger {
+	return l.WithField(logging.ErrKey, err)
+}
+
+func (l *Logger) WithFields(fields logging.Fields) logging.Logger {
+	merged := logging.Fields{}
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	return &Logger{
+		fields:  merged,
+		entries: l.entries,
+	}
+}
+
+func (l *Logger) Flush() error {
+	return nil
+}
+
+func (l *Logger) log(level, msg string) {
+	l.entries.mu.Lock()
+	defer l.entries.mu.Unlock()
+
+	l.entries.list = append(l.entries.list, Entry{Level: level, Message: msg, Fields: l.fields})
+}
@@ -0,0 +1,245 @@
+// Package migrate applies and rolls back versioned SQL schema migrations.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migration is a single versioned change to the schema, along with the SQL needed to undo it.
+type Migration struct {
+	Version     int
+	Description string
+	Up          string
+	Down        string
+}
+
+// Load reads the <version>_<description>.up.sql and .down.sql migration pairs in the root of
+// fsys, sorted by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading migrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		version, description, direction, err := parseFileName(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		contents, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("reading migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Description: description}
+			byVersion[version] = migration
+		}
+
+		if migration.Description != description {
+			return nil, fmt.Errorf("migration %d has more than one description", version)
+		}
+
+		switch direction {
+		case "up":
+			migration.Up = string(contents)
+		case "down":
+			migration.Down = string(contents)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d must have both an up and a down file", migration.Version)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func parseFileName(name string) (int, string, string, error) {
+	base := strings.TrimSuffix(name, ".sql")
+
+	direction := path.Ext(base)
+	if direction != ".up" && direction != ".down" {
+		return 0, "", "", fmt.Errorf("migration %s must end in .up.sql or .down.sql", name)
+	}
+	base = strings.TrimSuffix(base, direction)
+
+	versionStr, description, ok := strings.Cut(base, "_")
+	if !ok {
+		return 0, "", "", fmt.Errorf("migration %s must be named <version>_<description>", name)
+	}
+
+	version, err := strconv.Atoi(versionStr)
+	if err != nil || version <= 0 {
+		return 0, "", "", fmt.Errorf("migration %s does not start with a positive version number", name)
+	}
+
+	return version, description, strings.TrimPrefix(direction, "."), nil
+}
+
+// Migrator applies a fixed set of migrations to a database whose driver supports $n placeholders.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Version returns the version of the most recently applied migration, or 0 if no migrations
+// have been applied.
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+
+	var version sql.NullInt64
+	if err := m.db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("reading schema version: %w", err)
+	}
+
+	return int(version.Int64), nil
+}
+
+// Up applies every migration that has not yet been applied, in version order.
+func (m *Migrator) Up(ctx context.Context) error {
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range m.migrations {
+		if migration.Version <= current {
+			continue
+		}
+
+		err := m.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+				return err
+			}
+
+			_, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO schema_migrations (version, description, applied_at) VALUES ($1, $2, $3)`,
+				migration.Version,
+				migration.Description,
+				time.Now().UTC(),
+			)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("applying migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+	}
+
+	return nil
+}
+
+// Down rolls back the most recently applied migration. It does nothing if no migrations have
+// been applied.
+func (m *Migrator) Down(ctx context.Context) error {
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current == 0 {
+		return nil
+	}
+
+	for _, migration := range m.migrations {
+		if migration.Version != current {
+			continue
+		}
+
+		err := m.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+				return err
+			}
+
+			_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("rolling back migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("database is at version %d, which is not a known migration", current)
+}
+
+// Reset rolls back every applied migration, leaving the database empty.
+func (m *Migrator) Reset(ctx context.Context) error {
+	for {
+		current, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		if current == 0 {
+			return nil
+		}
+
+		if err := m.Down(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version     INTEGER PRIMARY KEY,
+		description TEXT NOT NULL,
+		applied_at  TIMESTAMP NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("creating schema_migrations table: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Migrator) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		// The error from the rollback is ignored, as the error that caused it is more useful.
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
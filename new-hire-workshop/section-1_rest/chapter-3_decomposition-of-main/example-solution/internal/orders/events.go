@@ -0,0 +1,33 @@
+package orders
+
+import (
+	"context"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventTypeOrderPlaced    EventType = "order.placed"
+	EventTypeOrderCancelled EventType = "order.cancelled"
+	// EventTypeOrderStatusChanged is for any other change of status, such as staff putting an
+	// order on hold.
+	EventTypeOrderStatusChanged EventType = "order.status_changed"
+)
+
+// Event describes a change to an order that has already happened, for consumers outside of the
+// order service to react to.
+type Event struct {
+	Type       EventType
+	TenantID   string
+	OrderID    string
+	CustomerID string
+	Status     OrderStatus
+	Reason     string
+	OccurredAt time.Time
+}
+
+// EventPublisher sends order events to downstream consumers.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
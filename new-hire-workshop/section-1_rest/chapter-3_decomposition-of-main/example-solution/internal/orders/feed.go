@@ -0,0 +1,151 @@
+package orders
+
+import (
+	"context"
+	"sync"
+)
+
+// Feed publishes each order as it is after every change to the subscribers watching it. It is
+// safe for concurrent use by multiple goroutines.
+type Feed struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription]struct{}
+	closed      bool
+}
+
+func NewFeed() *Feed {
+	return &Feed{
+		subscribers: map[string]map[*Subscription]struct{}{},
+	}
+}
+
+// Subscription receives the changes to a single order. It must be closed once it is no longer
+// needed.
+type Subscription struct {
+	feed    *Feed
+	orderID string
+	updates chan Order
+	done    chan struct{}
+}
+
+// Subscribe starts watching an order for changes. If the feed has been closed, the subscription
+// is returned already closed.
+func (f *Feed) Subscribe(orderID string) *Subscription {
+	sub := &Subscription{
+		feed:    f,
+		orderID: orderID,
+		updates: make(chan Order, 1),
+		done:    make(chan struct{}),
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		close(sub.done)
+		return sub
+	}
+
+	if f.subscribers[orderID] == nil {
+		f.subscribers[orderID] = map[*Subscription]struct{}{}
+	}
+	f.subscribers[orderID][sub] = struct{}{}
+
+	return sub
+}
+
+// Close closes every subscription, and any made after it.
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+
+	f.closed = true
+	for _, subs := range f.subscribers {
+		for sub := range subs {
+			close(sub.done)
+		}
+	}
+	f.subscribers = map[string]map[*Subscription]struct{}{}
+}
+
+// publish sends the order to everyone subscribed to it, replacing any earlier version of the order
+// that a subscriber has not received yet.
+func (f *Feed) publish(order Order) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for sub := range f.subscribers[order.OrderID] {
+		// Only publish sends on the channel, and it holds the lock while it does, so the channel
+		// is never full by the time the order is sent.
+		select {
+		case <-sub.updates:
+		default:
+		}
+		sub.updates <- order.clone()
+	}
+}
+
+// Updates returns the channel the order is sent on after each change.
+func (s *Subscription) Updates() <-chan Order {
+	return s.updates
+}
+
+// Done returns a channel that is closed when the subscription is closed, either by Close or by
+// the feed being closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscription) Close() {
+	s.feed.mu.Lock()
+	defer s.feed.mu.Unlock()
+
+	subs, ok := s.feed.subscribers[s.orderID]
+	if _, subscribed := subs[s]; !ok || !subscribed {
+		return
+	}
+
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(s.feed.subscribers, s.orderID)
+	}
+	close(s.done)
+}
+
+// FeedRepository wraps an OrderRepository, publishing every order written through it to a Feed.
+type FeedRepository struct {
+	OrderRepository
+	feed *Feed
+}
+
+var _ OrderRepository = (*FeedRepository)(nil)
+
+func NewFeedRepository(repo OrderRepository, feed *Feed) *FeedRepository {
+	return &FeedRepository{
+		OrderRepository: repo,
+		feed:            feed,
+	}
+}
+
+func (r *FeedRepository) CreateOrder(ctx context.Context, order Order) error {
+	if err := r.OrderRepository.CreateOrder(ctx, order); err != nil {
+		return err
+	}
+
+	r.feed.publish(order)
+	return nil
+}
+
+func (r *FeedRepository) UpdateOrder(ctx context.Context, order Order) (Order, error) {
+	updated, err := r.OrderRepository.UpdateOrder(ctx, order)
+	if err != nil {
+		return Order{}, err
+	}
+
+	r.feed.publish(updated)
+	return updated, nil
+}
@@ -0,0 +1,78 @@
+package orders
+
+import (
+	"fmt"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// transitions lists the statuses that an order can move to from each status. Delivered and
+// cancelled orders are finished with, so they cannot move anywhere.
+var transitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPlaced:    {OrderStatusTransit, OrderStatusOnHold, OrderStatusCancelled},
+	OrderStatusOnHold:    {OrderStatusPlaced, OrderStatusTransit, OrderStatusCancelled},
+	OrderStatusTransit:   {OrderStatusDelivered, OrderStatusOnHold},
+	OrderStatusDelivered: {},
+	OrderStatusCancelled: {},
+}
+
+// statusMessages are the delivery entry messages recorded when an order moves into each status.
+var statusMessages = map[OrderStatus]string{
+	OrderStatusPlaced:    "Order has been placed",
+	OrderStatusOnHold:    "Order has been put on hold",
+	OrderStatusTransit:   "Order is in transit",
+	OrderStatusDelivered: "Order has been delivered",
+	OrderStatusCancelled: "Order has been cancelled",
+}
+
+// CanTransition reports whether an order is allowed to move from one status to another.
+func CanTransition(from, to OrderStatus) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateTransition returns a terrors.StateConflict error if an order cannot move from one
+// status to another. Staying in the same status is not a transition, and is always allowed.
+func ValidateTransition(from, to OrderStatus) error {
+	if from == to || CanTransition(from, to) {
+		return nil
+	}
+
+	return newTransitionConflict(from, to)
+}
+
+func newTransitionConflict(from, to OrderStatus) error {
+	return terrors.Presentable(terrors.NewStateConflict(fmt.Sprintf("an order cannot move from %s to %s", from, to), nil))
+}
+
+// Transition moves the order to a new status, recording a delivery entry for the change. The
+// order is left unchanged if the move is not allowed.
+func (o *Order) Transition(to OrderStatus, reason string, at time.Time) error {
+	if !CanTransition(o.Status, to) {
+		return newTransitionConflict(o.Status, to)
+	}
+
+	message := statusMessages[to]
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+
+	o.Status = to
+	o.StatusReason = reason
+	o.DeliveryEntries = append(o.DeliveryEntries, DeliveryEntry{
+		Timestamp: at,
+		Message:   message,
+	})
+
+	if to == OrderStatusDelivered {
+		o.DeliveredAt = at
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package orders_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from, to orders.OrderStatus
+		allowed  bool
+	}{
+		{from: orders.OrderStatusPlaced, to: orders.OrderStatusPlaced, allowed: true},
+		{from: orders.OrderStatusPlaced, to: orders.OrderStatusOnHold, allowed: true},
+		{from: orders.OrderStatusPlaced, to: orders.OrderStatusTransit, allowed: true},
+		{from: orders.OrderStatusPlaced, to: orders.OrderStatusDelivered, allowed: false},
+		{from: orders.OrderStatusPlaced, to: orders.OrderStatusCancelled, allowed: true},
+
+		{from: orders.OrderStatusOnHold, to: orders.OrderStatusPlaced, allowed: true},
+		{from: orders.OrderStatusOnHold, to: orders.OrderStatusOnHold, allowed: true},
+		{from: orders.OrderStatusOnHold, to: orders.OrderStatusTransit, allowed: true},
+		{from: orders.OrderStatusOnHold, to: orders.OrderStatusDelivered, allowed: false},
+		{from: orders.OrderStatusOnHold, to: orders.OrderStatusCancelled, allowed: true},
+
+		{from: orders.OrderStatusTransit, to: orders.OrderStatusPlaced, allowed: false},
+		{from: orders.OrderStatusTransit, to: orders.OrderStatusOnHold, allowed: true},
+		{from: orders.OrderStatusTransit, to: orders.OrderStatusTransit, allowed: true},
+		{from: orders.OrderStatusTransit, to: orders.OrderStatusDelivered, allowed: true},
+		{from: orders.OrderStatusTransit, to: orders.OrderStatusCancelled, allowed: false},
+
+		{from: orders.OrderStatusDelivered, to: orders.OrderStatusPlaced, allowed: false},
+		{from: orders.OrderStatusDelivered, to: orders.OrderStatusOnHold, allowed: false},
+		{from: orders.OrderStatusDelivered, to: orders.OrderStatusTransit, allowed: false},
+		{from: orders.OrderStatusDelivered, to: orders.OrderStatusDelivered, allowed: true},
+		{from: orders.OrderStatusDelivered, to: orders.OrderStatusCancelled, allowed: false},
+
+		{from: orders.OrderStatusCancelled, to: orders.OrderStatusPlaced, allowed: false},
+		{from: orders.OrderStatusCancelled, to: orders.OrderStatusOnHold, allowed: false},
+		{from: orders.OrderStatusCancelled, to: orders.OrderStatusTransit, allowed: false},
+		{from: orders.OrderStatusCancelled, to: orders.OrderStatusDelivered, allowed: false},
+		{from: orders.OrderStatusCancelled, to: orders.OrderStatusCancelled, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			err := orders.ValidateTransition(tt.from, tt.to)
+			if tt.allowed {
+				if err != nil {
+					t.Errorf("ValidateTransition returned error: %v", err)
+				}
+				return
+			}
+
+			var conflict *terrors.StateConflict
+			if !errors.As(err, &conflict) {
+				t.Errorf("ValidateTransition returned %v, want a terrors.StateConflict", err)
+			}
+		})
+	}
+}
+
+func TestTransition(t *testing.T) {
+	order := orders.NewOrder(orders.DefaultTenantID, "customer", "product", "address", "payment")
+	shippedAt := time.Now()
+	deliveredAt := shippedAt.Add(time.Hour)
+
+	if err := order.Transition(orders.OrderStatusTransit, "", shippedAt); err != nil {
+		t.Fatalf("Transition to %s returned error: %v", orders.OrderStatusTransit, err)
+	}
+	if !order.DeliveredAt.IsZero() {
+		t.Errorf("order in transit has DeliveredAt %s, want it unset", order.DeliveredAt)
+	}
+
+	if err := order.Transition(orders.OrderStatusDelivered, "left with a neighbour", deliveredAt); err != nil {
+		t.Fatalf("Transition to %s returned error: %v", orders.OrderStatusDelivered, err)
+	}
+	if !order.DeliveredAt.Equal(deliveredAt) || order.StatusReason != "left with a neighbour" {
+		t.Errorf("delivered order has DeliveredAt %s and reason %q, want %s and the given reason", order.DeliveredAt, order.StatusReason, deliveredAt)
+	}
+
+	last := order.DeliveryEntries[len(order.DeliveryEntries)-1]
+	if want := "Order has been delivered: left with a neighbour"; last.Message != want || !last.Timestamp.Equal(deliveredAt) {
+		t.Errorf("last delivery entry is %+v, want %q at %s", last, want, deliveredAt)
+	}
+
+	// A delivered order is finished with, and is left as it is by a move it cannot make.
+	entries := len(order.DeliveryEntries)
+	var conflict *terrors.StateConflict
+	if err := order.Transition(orders.OrderStatusCancelled, "", deliveredAt); !errors.As(err, &conflict) {
+		t.Errorf("Transition of a delivered order returned %v, want a terrors.StateConflict", err)
+	}
+	if order.Status != orders.OrderStatusDelivered || len(order.DeliveryEntries) != entries {
+		t.Errorf("order is %s with %d delivery entries after a refused move, want it unchanged", order.Status, len(order.DeliveryEntries))
+	}
+}
@@ -0,0 +1,111 @@
+package orders
+
+import (
+	"sort"
+	"time"
+)
+
+// ListOptions narrows down and orders the orders returned by OrderRepository.ListOrders. The zero
+// value returns every order, oldest first.
+type ListOptions struct {
+	// Statuses limits the results to orders in any of the statuses. All statuses are included if
+	// it is empty.
+	Statuses []OrderStatus
+	// OrderedFrom limits the results to orders placed at or after this time, if it is set.
+	OrderedFrom time.Time
+	// OrderedBefore limits the results to orders placed before this time, if it is set.
+	OrderedBefore time.Time
+	// Descending returns the newest orders first.
+	Descending bool
+	// Limit is the maximum number of orders to return. There is no limit if it is 0.
+	Limit int
+	// After continues a previous listing from the order the cursor points to. It must be used with
+	// the same filters and sort order as the listing it came from.
+	After *Cursor
+}
+
+// Cursor identifies a position in a list of orders. Orders are sorted by when they were placed,
+// with the order ID breaking ties, so both are needed to find the position.
+type Cursor struct {
+	OrderedAt time.Time
+	OrderID   string
+}
+
+// OrderPage is one page of the results of listing orders.
+type OrderPage struct {
+	Orders []Order
+	// Next is the cursor to pass in ListOptions.After to fetch the following page. It is nil if
+	// this is the last page.
+	Next *Cursor
+}
+
+// Matches reports whether the order passes the status and date filters.
+func (l ListOptions) Matches(order Order) bool {
+	if len(l.Statuses) > 0 && !containsStatus(l.Statuses, order.Status) {
+		return false
+	}
+
+	if !l.OrderedFrom.IsZero() && order.OrderedAt.Before(l.OrderedFrom) {
+		return false
+	}
+
+	if !l.OrderedBefore.IsZero() && !order.OrderedAt.Before(l.OrderedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// Paginate sorts orders that have already been filtered, and cuts out the page of them described
+// by the options. It is intended for stores that cannot sort and page their results themselves.
+func (l ListOptions) Paginate(filtered []Order) OrderPage {
+	sort.Slice(filtered, func(i, j int) bool {
+		return l.before(cursorFor(filtered[i]), cursorFor(filtered[j]))
+	})
+
+	start := 0
+	if l.After != nil {
+		start = sort.Search(len(filtered), func(i int) bool {
+			return l.before(*l.After, cursorFor(filtered[i]))
+		})
+	}
+	filtered = filtered[start:]
+
+	if l.Limit <= 0 || len(filtered) <= l.Limit {
+		return OrderPage{Orders: filtered}
+	}
+
+	page := filtered[:l.Limit]
+	next := cursorFor(page[len(page)-1])
+	return OrderPage{Orders: page, Next: &next}
+}
+
+// before reports whether the position a comes before b in the requested sort order.
+func (l ListOptions) before(a, b Cursor) bool {
+	if !a.OrderedAt.Equal(b.OrderedAt) {
+		return a.OrderedAt.Before(b.OrderedAt) != l.Descending
+	}
+
+	if a.OrderID == b.OrderID {
+		return false
+	}
+
+	return (a.OrderID < b.OrderID) != l.Descending
+}
+
+func cursorFor(order Order) Cursor {
+	return Cursor{
+		OrderedAt: order.OrderedAt,
+		OrderID:   order.OrderID,
+	}
+}
+
+func containsStatus(statuses []OrderStatus, status OrderStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
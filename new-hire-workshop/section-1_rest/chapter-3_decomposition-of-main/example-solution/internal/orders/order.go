@@ -1,6 +1,13 @@
 package orders
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
 
 type OrderStatus string
 
@@ -17,14 +24,70 @@ type DeliveryEntry struct {
 	Message   string
 }
 
+// DefaultTenantID is the tenant of the orders that were stored before orders had tenants, and of
+// the requests to a development server that do not say which tenant they are for.
+const DefaultTenantID = "default"
+
 type Order struct {
+	// TenantID is the tenant that the order belongs to. Orders are only ever read and written on
+	// behalf of users of the same tenant.
+	TenantID        string
 	CustomerID      string
 	OrderID         string
 	Address         string
 	ProductID       string
 	PaymentID       string
 	Status          OrderStatus
+	StatusReason    string
 	DeliveryEntries []DeliveryEntry
 	OrderedAt       time.Time
 	DeliveredAt     time.Time
+	// Version starts at 1 and is incremented by the repository each time the order is updated,
+	// so that an update based on an out of date copy of the order can be detected.
+	Version int
+}
+
+// NewOrder creates a freshly placed order with a generated ID. The first delivery entry is
+// recorded at the same time as the order, so the customer can always see when it was placed.
+func NewOrder(tenantID, customerID, productID, address, paymentID string) Order {
+	now := time.Now()
+
+	return Order{
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		OrderID:    uuid.NewString(),
+		Address:    address,
+		ProductID:  productID,
+		PaymentID:  paymentID,
+		Status:     OrderStatusPlaced,
+		DeliveryEntries: []DeliveryEntry{
+			{
+				Timestamp: now,
+				Message:   statusMessages[OrderStatusPlaced],
+			},
+		},
+		OrderedAt:   now,
+		DeliveredAt: time.Time{},
+		Version:     1,
+	}
+}
+
+// ErrVersionConflict is the cause of the error returned by NewVersionConflict, so that callers can
+// tell an out of date update apart from other conflicts with errors.Is.
+var ErrVersionConflict = errors.New("order version does not match")
+
+// NewVersionConflict returns the error for an update to an order whose version is not the one
+// that is stored, which means another request has changed the order since it was read.
+func NewVersionConflict(orderID string) error {
+	return terrors.Presentable(terrors.NewStateConflict(fmt.Sprintf("order %s has been changed by another request", orderID), ErrVersionConflict))
+}
+
+// clone returns a deep copy of the order, so the copy's delivery entries can be modified without
+// affecting the original.
+func (o Order) clone() Order {
+	if o.DeliveryEntries != nil {
+		o.DeliveryEntries = append([]DeliveryEntry(nil), o.DeliveryEntries...)
+	}
+
+	return o
 }
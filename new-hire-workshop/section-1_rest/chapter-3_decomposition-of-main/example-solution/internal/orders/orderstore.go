@@ -1,57 +1,172 @@
 package orders
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// OrderStore is an in-memory implementation of OrderRepository. It is safe for concurrent use by
+// multiple goroutines.
 type OrderStore struct {
-	orders []Order
+	mu sync.RWMutex
+	// orders holds every order in the store, keyed by order ID.
+	orders map[string]Order
+	// customerOrders holds the IDs of each customer's orders, in the order they were placed.
+	customerOrders map[customerKey][]string
+}
+
+// customerKey identifies a customer, as customers are scoped to their tenant.
+type customerKey struct {
+	tenantID   string
+	customerID string
 }
 
+var _ OrderRepository = (*OrderStore)(nil)
+
 func NewOrderStore() *OrderStore {
-	return &OrderStore{
-		orders: []Order{
-			{
-				CustomerID: "customer1",
-				OrderID:    "order-1",
-				Address:    "1 Longdown Avenue, BUK03",
-				ProductID:  "HPE Alletra",
-				PaymentID:  "1",
-				Status:     OrderStatusPlaced,
-				DeliveryEntries: []DeliveryEntry{
-					{
-						Timestamp: time.Now(),
-						Message:   "Order has been placed",
-					},
+	store := &OrderStore{
+		orders:         map[string]Order{},
+		customerOrders: map[customerKey][]string{},
+	}
+
+	for _, order := range SampleOrders() {
+		store.insert(order)
+	}
+
+	return store
+}
+
+// SampleOrders returns the orders that a new store is populated with, for use in manual testing.
+func SampleOrders() []Order {
+	return []Order{
+		{
+			TenantID:   DefaultTenantID,
+			CustomerID: "64367ef5-2dbf-4b1e-8fe9-2b27ff8f08ea",
+			OrderID:    "5194f551-8698-4a6f-99e7-9a39afe47350",
+			Address:    "1 Longdown Avenue, BUK03",
+			ProductID:  "59a01784-e37b-42fd-9a2e-41dce95bccde",
+			PaymentID:  "91b6c5d2-5aa0-4fab-b146-4b0959b7ae5f",
+			Status:     OrderStatusPlaced,
+			DeliveryEntries: []DeliveryEntry{
+				{
+					Timestamp: time.Now(),
+					Message:   statusMessages[OrderStatusPlaced],
 				},
-				OrderedAt:   time.Now(),
-				DeliveredAt: time.Time{},
 			},
-			{
-				CustomerID: "customer2",
-				OrderID:    "order-2",
-				Address:    "Pepenero",
-				ProductID:  "Margerita",
-				PaymentID:  "2",
-				Status:     OrderStatusPlaced,
-				DeliveryEntries: []DeliveryEntry{
-					{
-						Timestamp: time.Now(),
-						Message:   "Order has been placed",
-					},
+			OrderedAt:   time.Now(),
+			DeliveredAt: time.Time{},
+			Version:     1,
+		},
+		{
+			TenantID:   DefaultTenantID,
+			CustomerID: "1f48f886-4b7b-4595-95f1-dce8e0d7fc13",
+			OrderID:    "da270e29-b26f-4321-a084-bd439c4e1654",
+			Address:    "Pepenero",
+			ProductID:  "eeeeb30b-a65e-4c6e-917e-34da15a5f030",
+			PaymentID:  "54ae8356-7f2f-4238-8a63-0607bc4c8a6a",
+			Status:     OrderStatusPlaced,
+			DeliveryEntries: []DeliveryEntry{
+				{
+					Timestamp: time.Now(),
+					Message:   statusMessages[OrderStatusPlaced],
 				},
-				OrderedAt:   time.Now(),
-				DeliveredAt: time.Time{},
 			},
+			OrderedAt:   time.Now(),
+			DeliveredAt: time.Time{},
+			Version:     1,
 		},
 	}
 }
 
-func (o *OrderStore) GetOrders(customerID string) []Order {
-	var customerOrders []Order
-	for _, order := range o.orders {
-		if order.CustomerID == customerID {
-			customerOrders = append(customerOrders, order)
+func (o *OrderStore) ListOrders(_ context.Context, tenantID, customerID string, opts ListOptions) (OrderPage, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var matching []Order
+	for _, orderID := range o.customerOrders[customerKey{tenantID: tenantID, customerID: customerID}] {
+		if order := o.orders[orderID]; opts.Matches(order) {
+			matching = append(matching, order)
 		}
 	}
 
-	return customerOrders
+	page := opts.Paginate(matching)
+	for i := range page.Orders {
+		page.Orders[i] = page.Orders[i].clone()
+	}
+
+	return page, nil
+}
+
+// GetOrder returns the order with the specified ID. An order that belongs to a different customer
+// is reported as not found, so that customers cannot discover the IDs of other customers' orders.
+func (o *OrderStore) GetOrder(_ context.Context, tenantID, customerID, orderID string) (Order, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	order, ok := o.orders[orderID]
+	if !ok || order.TenantID != tenantID || order.CustomerID != customerID {
+		return Order{}, terrors.Presentable(terrors.NewNotFound("order", "id", orderID, nil))
+	}
+
+	return order.clone(), nil
+}
+
+func (o *OrderStore) GetOrderByID(_ context.Context, tenantID, orderID string) (Order, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	order, ok := o.orders[orderID]
+	if !ok || order.TenantID != tenantID {
+		return Order{}, terrors.Presentable(terrors.NewNotFound("order", "id", orderID, nil))
+	}
+
+	return order.clone(), nil
+}
+
+func (o *OrderStore) CreateOrder(ctx context.Context, order Order) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.orders[order.OrderID]; ok {
+		return terrors.Presentable(terrors.NewStateConflict(fmt.Sprintf("order %s already exists", order.OrderID), nil))
+	}
+
+	o.insert(order)
+	LogOrderWrite(ctx, "created order", order)
+	return nil
+}
+
+func (o *OrderStore) UpdateOrder(ctx context.Context, order Order) (Order, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	existing, ok := o.orders[order.OrderID]
+	if !ok || existing.TenantID != order.TenantID || existing.CustomerID != order.CustomerID {
+		return Order{}, terrors.Presentable(terrors.NewNotFound("order", "id", order.OrderID, nil))
+	}
+
+	if existing.Version != order.Version {
+		return Order{}, NewVersionConflict(order.OrderID)
+	}
+
+	if err := ValidateTransition(existing.Status, order.Status); err != nil {
+		return Order{}, err
+	}
+
+	order.Version++
+	o.orders[order.OrderID] = order.clone()
+	LogOrderWrite(ctx, "updated order", order)
+	return order.clone(), nil
+}
+
+// insert adds the order to both indexes. The caller must hold the write lock (or be the
+// constructor) and must have checked that the order ID is not already in use.
+func (o *OrderStore) insert(order Order) {
+	o.orders[order.OrderID] = order.clone()
+	key := customerKey{tenantID: order.TenantID, customerID: order.CustomerID}
+	o.customerOrders[key] = append(o.customerOrders[key], order.OrderID)
 }
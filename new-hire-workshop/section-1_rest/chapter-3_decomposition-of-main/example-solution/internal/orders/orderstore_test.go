@@ -0,0 +1,161 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestOrderStoreConcurrentAccess uses the store from many goroutines at once. Run it with -race.
+func TestOrderStoreConcurrentAccess(t *testing.T) {
+	const (
+		customers          = 4
+		ordersPerCustomer  = 20
+		readersPerCustomer = 2
+		updaters           = 2
+	)
+
+	ctx := context.Background()
+	store := NewOrderStore()
+
+	var placers, updating, readers sync.WaitGroup
+	placed := make(chan Order, customers*ordersPerCustomer*updaters)
+	stop := make(chan struct{})
+	errs := make(chan error, customers*(readersPerCustomer+1)+updaters)
+
+	for c := 0; c < customers; c++ {
+		customerID := fmt.Sprintf("customer-%d", c)
+
+		placers.Add(1)
+		go func() {
+			defer placers.Done()
+			for i := 0; i < ordersPerCustomer; i++ {
+				order := NewOrder(DefaultTenantID, customerID, "product", "address", "payment")
+				if err := store.CreateOrder(ctx, order); err != nil {
+					errs <- fmt.Errorf("placing order: %w", err)
+					return
+				}
+				for u := 0; u < updaters; u++ {
+					placed <- order
+				}
+			}
+		}()
+
+		for r := 0; r < readersPerCustomer; r++ {
+			readers.Add(1)
+			go func() {
+				defer readers.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+
+					page, err := store.ListOrders(ctx, DefaultTenantID, customerID, ListOptions{Descending: true})
+					if err != nil {
+						errs <- fmt.Errorf("listing orders: %w", err)
+						return
+					}
+
+					for _, order := range page.Orders {
+						if order.CustomerID != customerID {
+							errs <- fmt.Errorf("listing orders of %s returned order of %s", customerID, order.CustomerID)
+							return
+						}
+
+						got, err := store.GetOrder(ctx, DefaultTenantID, customerID, order.OrderID)
+						if err != nil {
+							errs <- fmt.Errorf("getting order: %w", err)
+							return
+						}
+
+						// The store must have handed out a copy, so changing it is not a race.
+						got.DeliveryEntries = append(got.DeliveryEntries, DeliveryEntry{Message: "changed by a reader"})
+						got.DeliveryEntries[0].Message = "changed by a reader"
+					}
+				}
+			}()
+		}
+	}
+
+	// Every order is sent to each of the updaters, which race to move it on, so that some of their
+	// updates are made with an out of date version and have to be retried.
+	for u := 0; u < updaters; u++ {
+		updating.Add(1)
+		go func() {
+			defer updating.Done()
+			for order := range placed {
+				if err := moveToTransit(ctx, store, order); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	placers.Wait()
+	close(placed)
+	updating.Wait()
+	close(stop)
+	readers.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	for c := 0; c < customers; c++ {
+		customerID := fmt.Sprintf("customer-%d", c)
+		page, err := store.ListOrders(ctx, DefaultTenantID, customerID, ListOptions{})
+		if err != nil {
+			t.Fatalf("listing orders: %v", err)
+		}
+
+		if len(page.Orders) != ordersPerCustomer {
+			t.Errorf("%s has %d orders, want %d", customerID, len(page.Orders), ordersPerCustomer)
+		}
+
+		for _, order := range page.Orders {
+			if order.Status != OrderStatusTransit || order.Version != 2 {
+				t.Errorf("order %s is %s at version %d, want %s at version 2", order.OrderID, order.Status, order.Version, OrderStatusTransit)
+			}
+
+			if len(order.DeliveryEntries) != 2 || order.DeliveryEntries[0].Message != statusMessages[OrderStatusPlaced] {
+				t.Errorf("order %s has delivery entries %v, want the placed and in transit entries only", order.OrderID, order.DeliveryEntries)
+			}
+		}
+	}
+}
+
+// moveToTransit moves the order to in transit unless it has been already, retrying if another
+// update gets there first.
+func moveToTransit(ctx context.Context, store *OrderStore, placed Order) error {
+	for {
+		order, err := store.GetOrder(ctx, placed.TenantID, placed.CustomerID, placed.OrderID)
+		if err != nil {
+			return fmt.Errorf("getting order to update: %w", err)
+		}
+
+		if order.Status == OrderStatusTransit {
+			return nil
+		}
+
+		if err := order.Transition(OrderStatusTransit, "", time.Now()); err != nil {
+			return err
+		}
+
+		_, err = store.UpdateOrder(ctx, order)
+		if errors.Is(err, ErrVersionConflict) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("updating order: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package orders
+
+import (
+	"context"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+// OrderRepository is the access point for reading and writing orders. Every method is confined
+// to a single tenant.
+type OrderRepository interface {
+	// ListOrders returns a page of the orders placed by the customer, filtered and sorted as
+	// described by the options.
+	ListOrders(ctx context.Context, tenantID, customerID string, opts ListOptions) (OrderPage, error)
+
+	// GetOrder returns the customer's order with the specified ID, or a terrors.NotFound error if
+	// the customer has no such order.
+	GetOrder(ctx context.Context, tenantID, customerID, orderID string) (Order, error)
+
+	// GetOrderByID returns the tenant's order with the specified ID whichever customer it belongs
+	// to, or a terrors.NotFound error if there is no such order. It is for staff, who look after
+	// every customer's orders; requests from customers must go through GetOrder.
+	GetOrderByID(ctx context.Context, tenantID, orderID string) (Order, error)
+
+	// CreateOrder stores a new order, returning a terrors.StateConflict error if an order with the
+	// same ID already exists.
+	CreateOrder(ctx context.Context, order Order) error
+
+	// UpdateOrder replaces a stored order with the one provided, returning a terrors.NotFound
+	// error if the order does not exist in its tenant. Changes of status must follow the order
+	// lifecycle (see CanTransition), and a terrors.StateConflict error is returned if they do not.
+	//
+	// The order's Version must match the stored order's, or a terrors.StateConflict error is
+	// returned as the order has been changed since it was read. The order is stored with the next
+	// version, and returned as stored.
+	UpdateOrder(ctx context.Context, order Order) (Order, error)
+}
+
+// LogOrderWrite logs a change to an order with the logger of the request that made it.
+func LogOrderWrite(ctx context.Context, msg string, order Order) {
+	logctx.Logger(ctx, logging.NewNoopLogger()).WithFields(logging.Fields{
+		"order-id": order.OrderID,
+		"status":   order.Status,
+		"version":  order.Version,
+	}).Debug(msg)
+}
@@ -0,0 +1,12 @@
+package orders_test
+
+import (
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders/repositorytest"
+)
+
+func TestOrderStoreRepository(t *testing.T) {
+	repositorytest.TestOrderRepository(t, orders.NewOrderStore())
+}
@@ -0,0 +1,405 @@
+// Package repositorytest is a suite of tests for the contract of orders.OrderRepository.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// TestOrderRepository runs the contract tests against the repository, each under a tenant of its
+// own.
+func TestOrderRepository(t *testing.T, repo orders.OrderRepository) {
+	t.Run("CreateAndGet", func(t *testing.T) { testCreateAndGet(t, repo) })
+	t.Run("CreateDuplicate", func(t *testing.T) { testCreateDuplicate(t, repo) })
+	t.Run("GetOtherCustomersOrder", func(t *testing.T) { testGetOtherCustomersOrder(t, repo) })
+	t.Run("ListFiltersAndSorts", func(t *testing.T) { testListFiltersAndSorts(t, repo) })
+	t.Run("ListPages", func(t *testing.T) { testListPages(t, repo) })
+	t.Run("Update", func(t *testing.T) { testUpdate(t, repo) })
+	t.Run("UpdateOutOfDateVersion", func(t *testing.T) { testUpdateOutOfDateVersion(t, repo) })
+	t.Run("UpdateInvalidTransition", func(t *testing.T) { testUpdateInvalidTransition(t, repo) })
+	t.Run("UpdateOtherCustomersOrder", func(t *testing.T) { testUpdateOtherCustomersOrder(t, repo) })
+	t.Run("OtherTenantsOrder", func(t *testing.T) { testOtherTenantsOrder(t, repo) })
+}
+
+func testCreateAndGet(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	got, err := repo.GetOrder(ctx, order.TenantID, order.CustomerID, order.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrder returned error: %v", err)
+	}
+	AssertOrderEqual(t, got, order)
+
+	got, err = repo.GetOrderByID(ctx, order.TenantID, order.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrderByID returned error: %v", err)
+	}
+	AssertOrderEqual(t, got, order)
+
+	_, err = repo.GetOrder(ctx, order.TenantID, order.CustomerID, uuid.NewString())
+	AssertNotFound(t, err)
+}
+
+func testCreateDuplicate(t *testing.T, repo orders.OrderRepository) {
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	err := repo.CreateOrder(context.Background(), order)
+	var conflict *terrors.StateConflict
+	if !errors.As(err, &conflict) {
+		t.Fatalf("creating an order twice returned %v, want a terrors.StateConflict", err)
+	}
+}
+
+func testGetOtherCustomersOrder(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	_, err := repo.GetOrder(ctx, order.TenantID, uuid.NewString(), order.OrderID)
+	AssertNotFound(t, err)
+
+	page, err := repo.ListOrders(ctx, order.TenantID, uuid.NewString(), orders.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListOrders returned error: %v", err)
+	}
+	if len(page.Orders) != 0 {
+		t.Errorf("another customer's listing has %d orders, want none", len(page.Orders))
+	}
+}
+
+func testListFiltersAndSorts(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	tenantID, customerID := NewTenantID(), uuid.NewString()
+	start := time.Now().Add(-time.Hour)
+
+	var placed []orders.Order
+	for i := 0; i < 4; i++ {
+		order := NewOrder(tenantID, customerID, start.Add(time.Duration(i)*time.Minute))
+		MustCreate(t, repo, order)
+		placed = append(placed, order)
+	}
+
+	held := placed[1]
+	if err := held.Transition(orders.OrderStatusOnHold, "", time.Now()); err != nil {
+		t.Fatalf("moving order on hold: %v", err)
+	}
+	if _, err := repo.UpdateOrder(ctx, held); err != nil {
+		t.Fatalf("UpdateOrder returned error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts orders.ListOptions
+		want []orders.Order
+	}{
+		{
+			name: "everything, oldest first",
+			want: placed,
+		},
+		{
+			name: "newest first",
+			opts: orders.ListOptions{Descending: true},
+			want: []orders.Order{placed[3], placed[2], placed[1], placed[0]},
+		},
+		{
+			name: "by status",
+			opts: orders.ListOptions{Statuses: []orders.OrderStatus{orders.OrderStatusOnHold}},
+			want: []orders.Order{placed[1]},
+		},
+		{
+			name: "by date",
+			opts: orders.ListOptions{OrderedFrom: placed[1].OrderedAt, OrderedBefore: placed[3].OrderedAt},
+			want: []orders.Order{placed[1], placed[2]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.ListOrders(ctx, tenantID, customerID, tt.opts)
+			if err != nil {
+				t.Fatalf("ListOrders returned error: %v", err)
+			}
+
+			assertOrderIDs(t, page.Orders, tt.want)
+			if page.Next != nil {
+				t.Errorf("listing without a limit returned a next page cursor")
+			}
+		})
+	}
+}
+
+func testListPages(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	tenantID, customerID := NewTenantID(), uuid.NewString()
+
+	// The orders are all placed at the same time, so the order IDs alone decide where each
+	// page starts.
+	placedAt := time.Now()
+	var placed []orders.Order
+	for i := 0; i < 5; i++ {
+		order := NewOrder(tenantID, customerID, placedAt)
+		MustCreate(t, repo, order)
+		placed = append(placed, order)
+	}
+
+	for _, descending := range []bool{false, true} {
+		opts := orders.ListOptions{Descending: descending, Limit: 2}
+		var listed []orders.Order
+		for pages := 1; ; pages++ {
+			page, err := repo.ListOrders(ctx, tenantID, customerID, opts)
+			if err != nil {
+				t.Fatalf("ListOrders returned error: %v", err)
+			}
+
+			listed = append(listed, page.Orders...)
+			if page.Next == nil {
+				if pages != 3 {
+					t.Errorf("listing with descending %t took %d pages, want 3", descending, pages)
+				}
+				break
+			}
+
+			if pages == 3 {
+				t.Fatalf("listing with descending %t has more than 3 pages", descending)
+			}
+			opts.After = page.Next
+		}
+
+		want := orders.ListOptions{Descending: descending}.Paginate(append([]orders.Order(nil), placed...)).Orders
+		assertOrderIDs(t, listed, want)
+	}
+}
+
+func testUpdate(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	for _, status := range []orders.OrderStatus{orders.OrderStatusTransit, orders.OrderStatusDelivered} {
+		if err := order.Transition(status, "reason", Now()); err != nil {
+			t.Fatalf("moving order to %s: %v", status, err)
+		}
+
+		updated, err := repo.UpdateOrder(ctx, order)
+		if err != nil {
+			t.Fatalf("UpdateOrder returned error: %v", err)
+		}
+
+		order.Version++
+		AssertOrderEqual(t, updated, order)
+
+		got, err := repo.GetOrder(ctx, order.TenantID, order.CustomerID, order.OrderID)
+		if err != nil {
+			t.Fatalf("GetOrder returned error: %v", err)
+		}
+		AssertOrderEqual(t, got, order)
+	}
+}
+
+func testUpdateOutOfDateVersion(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	first := order
+	if err := first.Transition(orders.OrderStatusOnHold, "", Now()); err != nil {
+		t.Fatalf("moving order on hold: %v", err)
+	}
+	if _, err := repo.UpdateOrder(ctx, first); err != nil {
+		t.Fatalf("UpdateOrder returned error: %v", err)
+	}
+
+	second := order
+	if err := second.Transition(orders.OrderStatusCancelled, "", Now()); err != nil {
+		t.Fatalf("cancelling order: %v", err)
+	}
+	if _, err := repo.UpdateOrder(ctx, second); !errors.Is(err, orders.ErrVersionConflict) {
+		t.Fatalf("updating an out of date order returned %v, want orders.ErrVersionConflict", err)
+	}
+
+	got, err := repo.GetOrder(ctx, order.TenantID, order.CustomerID, order.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrder returned error: %v", err)
+	}
+	if got.Status != orders.OrderStatusOnHold || got.Version != 2 {
+		t.Errorf("order is %s at version %d, want %s at version 2", got.Status, got.Version, orders.OrderStatusOnHold)
+	}
+}
+
+func testUpdateInvalidTransition(t *testing.T, repo orders.OrderRepository) {
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	// Transition would refuse the move, so the status is set directly, as a careless caller might.
+	order.Status = orders.OrderStatusDelivered
+	_, err := repo.UpdateOrder(context.Background(), order)
+	var conflict *terrors.StateConflict
+	if !errors.As(err, &conflict) || errors.Is(err, orders.ErrVersionConflict) {
+		t.Fatalf("skipping a status returned %v, want a terrors.StateConflict", err)
+	}
+}
+
+func testUpdateOtherCustomersOrder(t *testing.T, repo orders.OrderRepository) {
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+
+	order.CustomerID = uuid.NewString()
+	order.Address = "Somewhere else"
+	_, err := repo.UpdateOrder(context.Background(), order)
+	AssertNotFound(t, err)
+}
+
+// testOtherTenantsOrder checks that an order cannot be reached from another tenant, even by a
+// customer with the same ID, as customer IDs are only unique within a tenant.
+func testOtherTenantsOrder(t *testing.T, repo orders.OrderRepository) {
+	ctx := context.Background()
+	order := NewOrder(NewTenantID(), uuid.NewString(), time.Now())
+	MustCreate(t, repo, order)
+	otherTenantID := NewTenantID()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "GetOrder",
+			call: func() error {
+				_, err := repo.GetOrder(ctx, otherTenantID, order.CustomerID, order.OrderID)
+				return err
+			},
+		},
+		{
+			name: "GetOrderByID",
+			call: func() error {
+				_, err := repo.GetOrderByID(ctx, otherTenantID, order.OrderID)
+				return err
+			},
+		},
+		{
+			name: "UpdateOrder",
+			call: func() error {
+				moved := order
+				moved.TenantID = otherTenantID
+				if err := moved.Transition(orders.OrderStatusCancelled, "", Now()); err != nil {
+					t.Fatalf("cancelling order: %v", err)
+				}
+
+				_, err := repo.UpdateOrder(ctx, moved)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AssertNotFound(t, tt.call())
+		})
+	}
+
+	page, err := repo.ListOrders(ctx, otherTenantID, order.CustomerID, orders.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListOrders returned error: %v", err)
+	}
+	if len(page.Orders) != 0 {
+		t.Errorf("the other tenant's listing has %d orders, want none", len(page.Orders))
+	}
+
+	got, err := repo.GetOrder(ctx, order.TenantID, order.CustomerID, order.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrder returned error: %v", err)
+	}
+	AssertOrderEqual(t, got, order)
+}
+
+// NewTenantID returns a tenant ID that no other test uses.
+func NewTenantID() string {
+	return "test-" + uuid.NewString()
+}
+
+// Now returns the current time, cut down to the microseconds that every store can hold.
+func Now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// NewOrder returns a newly placed order for the customer, placed at the time given.
+func NewOrder(tenantID, customerID string, placedAt time.Time) orders.Order {
+	order := orders.NewOrder(tenantID, customerID, uuid.NewString(), "1 Test Street", uuid.NewString())
+	placedAt = placedAt.Truncate(time.Microsecond)
+	order.OrderedAt = placedAt
+	order.DeliveryEntries[0].Timestamp = placedAt
+	return order
+}
+
+// MustCreate stores the order, failing the test if it cannot be.
+func MustCreate(t *testing.T, repo orders.OrderRepository, order orders.Order) {
+	t.Helper()
+
+	if err := repo.CreateOrder(context.Background(), order); err != nil {
+		t.Fatalf("CreateOrder returned error: %v", err)
+	}
+}
+
+// AssertNotFound fails the test if the error is not a terrors.NotFound.
+func AssertNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	var notFound *terrors.NotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("got error %v, want a terrors.NotFound", err)
+	}
+}
+
+// AssertOrderEqual fails the test if the orders differ. Times are compared as instants, as the
+// stores do not all keep their location.
+func AssertOrderEqual(t *testing.T, got, want orders.Order) {
+	t.Helper()
+
+	gotEntries, wantEntries := got.DeliveryEntries, want.DeliveryEntries
+	gotOrderedAt, wantOrderedAt := got.OrderedAt, want.OrderedAt
+	gotDeliveredAt, wantDeliveredAt := got.DeliveredAt, want.DeliveredAt
+	got.DeliveryEntries, want.DeliveryEntries = nil, nil
+	got.OrderedAt, want.OrderedAt = time.Time{}, time.Time{}
+	got.DeliveredAt, want.DeliveredAt = time.Time{}, time.Time{}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got order %+v, want %+v", got, want)
+	}
+
+	if !gotOrderedAt.Equal(wantOrderedAt) || !gotDeliveredAt.Equal(wantDeliveredAt) {
+		t.Errorf("order was placed at %s and delivered at %s, want %s and %s", gotOrderedAt, gotDeliveredAt, wantOrderedAt, wantDeliveredAt)
+	}
+
+	if len(gotEntries) != len(wantEntries) {
+		t.Fatalf("got %d delivery entries, want %d", len(gotEntries), len(wantEntries))
+	}
+
+	for i := range gotEntries {
+		if gotEntries[i].Message != wantEntries[i].Message || !gotEntries[i].Timestamp.Equal(wantEntries[i].Timestamp) {
+			t.Errorf("delivery entry %d is %+v, want %+v", i, gotEntries[i], wantEntries[i])
+		}
+	}
+}
+
+func assertOrderIDs(t *testing.T, got, want []orders.Order) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d orders, want %d", len(got), len(want))
+	}
+
+	for i := range got {
+		if got[i].OrderID != want[i].OrderID {
+			t.Errorf("order %d is %s, want %s", i, got[i].OrderID, want[i].OrderID)
+		}
+	}
+}
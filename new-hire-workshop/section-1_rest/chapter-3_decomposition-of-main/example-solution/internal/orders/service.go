@@ -0,0 +1,113 @@
+package orders
+
+import (
+	"context"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+// Service provides the operations on orders that involve more than a single call to the
+// repository, such as moving an order through its lifecycle and telling other services about it.
+type Service struct {
+	repo      OrderRepository
+	publisher EventPublisher
+	logger    logging.Logger
+}
+
+func NewService(repo OrderRepository, publisher EventPublisher, logger logging.Logger) *Service {
+	return &Service{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// PlaceOrder places a new order for the tenant's customer.
+func (s *Service) PlaceOrder(ctx context.Context, tenantID, customerID, productID, address, paymentID string) (Order, error) {
+	order := NewOrder(tenantID, customerID, productID, address, paymentID)
+	if err := s.repo.CreateOrder(ctx, order); err != nil {
+		return Order{}, err
+	}
+
+	s.publish(ctx, Event{
+		Type:       EventTypeOrderPlaced,
+		TenantID:   order.TenantID,
+		OrderID:    order.OrderID,
+		CustomerID: order.CustomerID,
+		Status:     order.Status,
+		OccurredAt: order.OrderedAt,
+	})
+
+	return order, nil
+}
+
+// CancelOrder cancels one of the customer's orders. If version is not 0, the order is only
+// cancelled if it is still at that version.
+func (s *Service) CancelOrder(ctx context.Context, tenantID, customerID, orderID, reason string, version int) (Order, error) {
+	order, err := s.repo.GetOrder(ctx, tenantID, customerID, orderID)
+	if err != nil {
+		return Order{}, err
+	}
+
+	return s.transition(ctx, order, OrderStatusCancelled, reason, version)
+}
+
+// HoldOrder puts any of the tenant's orders on hold. Version works as it does for CancelOrder.
+func (s *Service) HoldOrder(ctx context.Context, tenantID, orderID, reason string, version int) (Order, error) {
+	return s.SetStatus(ctx, tenantID, orderID, OrderStatusOnHold, reason, version)
+}
+
+// SetStatus moves any of the tenant's orders to a new status. Version works as it does for
+// CancelOrder.
+func (s *Service) SetStatus(ctx context.Context, tenantID, orderID string, status OrderStatus, reason string, version int) (Order, error) {
+	order, err := s.repo.GetOrderByID(ctx, tenantID, orderID)
+	if err != nil {
+		return Order{}, err
+	}
+
+	return s.transition(ctx, order, status, reason, version)
+}
+
+// transition moves the order to a new status and publishes the change.
+func (s *Service) transition(ctx context.Context, order Order, status OrderStatus, reason string, version int) (Order, error) {
+	if version != 0 && order.Version != version {
+		return Order{}, NewVersionConflict(order.OrderID)
+	}
+
+	now := time.Now()
+	if err := order.Transition(status, reason, now); err != nil {
+		return Order{}, err
+	}
+
+	order, err := s.repo.UpdateOrder(ctx, order)
+	if err != nil {
+		return Order{}, err
+	}
+
+	eventType := EventTypeOrderStatusChanged
+	if status == OrderStatusCancelled {
+		eventType = EventTypeOrderCancelled
+	}
+
+	s.publish(ctx, Event{
+		Type:       eventType,
+		TenantID:   order.TenantID,
+		OrderID:    order.OrderID,
+		CustomerID: order.CustomerID,
+		Status:     order.Status,
+		Reason:     reason,
+		OccurredAt: now,
+	})
+
+	return order, nil
+}
+
+// publish publishes an event about a change that has already been made. The change has happened
+// whether or not the event is published, so a failure to publish is logged rather than returned.
+func (s *Service) publish(ctx context.Context, event Event) {
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		logctx.Logger(ctx, s.logger).WithError(err).WithField("event", event).Error("failed to publish order event")
+	}
+}
@@ -0,0 +1,169 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// warehouseMoves are the moves that the simulated warehouse makes, from the status an order is in
+// to the status the warehouse moves it to.
+var warehouseMoves = map[OrderStatus]OrderStatus{
+	OrderStatusPlaced:  OrderStatusTransit,
+	OrderStatusTransit: OrderStatusDelivered,
+}
+
+// Warehouse simulates sending out and delivering placed orders, for development and demos. Orders
+// that someone else has moved on are left alone.
+type Warehouse struct {
+	delay  time.Duration
+	logger logging.Logger
+
+	mu sync.Mutex
+	// moves are the moves that are waiting to be made, in the order they fall due.
+	moves []warehouseMove
+	// wake tells Run that a move has been added, so that it can work out when the next move is due.
+	wake chan struct{}
+}
+
+// warehouseMove is a move of an order that is still in the status it was in when the move was
+// planned.
+type warehouseMove struct {
+	tenantID string
+	orderID  string
+	from     OrderStatus
+	to       OrderStatus
+	due      time.Time
+}
+
+var _ EventPublisher = (*Warehouse)(nil)
+
+// NewWarehouse creates a warehouse that takes the delay to send each order out, and the same again
+// to deliver it.
+func NewWarehouse(delay time.Duration, logger logging.Logger) *Warehouse {
+	return &Warehouse{
+		delay:  delay,
+		logger: logger,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Publish plans the next move of an order that has been placed (or released from hold) or sent
+// out. Any other event is of no interest to the warehouse.
+func (w *Warehouse) Publish(_ context.Context, event Event) error {
+	to, ok := warehouseMoves[event.Status]
+	if !ok {
+		return nil
+	}
+
+	w.mu.Lock()
+	w.moves = append(w.moves, warehouseMove{
+		tenantID: event.TenantID,
+		orderID:  event.OrderID,
+		from:     event.Status,
+		to:       to,
+		due:      time.Now().Add(w.delay),
+	})
+	w.mu.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Run makes the moves as they fall due, through the service, until the context is cancelled.
+func (w *Warehouse) Run(ctx context.Context, service *Service) {
+	timer := time.NewTimer(w.delay)
+	defer timer.Stop()
+
+	for {
+		for _, move := range w.takeDue(time.Now()) {
+			w.move(ctx, service, move)
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(w.untilNext(time.Now()))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-w.wake:
+		}
+	}
+}
+
+// takeDue removes the moves that are due at now from the plan and returns them.
+func (w *Warehouse) takeDue(now time.Time) []warehouseMove {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Every move is planned with the same delay, so the moves fall due in the order they were
+	// planned in.
+	due := 0
+	for due < len(w.moves) && !w.moves[due].due.After(now) {
+		due++
+	}
+
+	taken := append([]warehouseMove(nil), w.moves[:due]...)
+	w.moves = w.moves[due:]
+	return taken
+}
+
+// untilNext returns how long it is until the next move is due, or the delay if there are none.
+func (w *Warehouse) untilNext(now time.Time) time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.moves) == 0 {
+		return w.delay
+	}
+
+	return w.moves[0].due.Sub(now)
+}
+
+// move makes a move, unless the order has been moved on since the move was planned.
+func (w *Warehouse) move(ctx context.Context, service *Service, move warehouseMove) {
+	logger := w.logger.WithFields(logging.Fields{
+		"order-id":  move.orderID,
+		"tenant-id": move.tenantID,
+		"status":    move.to,
+	})
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	order, err := service.repo.GetOrderByID(ctx, move.tenantID, move.orderID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get order for the warehouse to move")
+		return
+	}
+
+	if order.Status != move.from {
+		return
+	}
+
+	// The move is made at the version that was just read, so a change made in the meantime wins.
+	if _, err := service.transition(ctx, order, move.to, "", order.Version); err != nil {
+		conflictErr := &terrors.StateConflict{}
+		if errors.As(err, &conflictErr) {
+			logger.WithError(err).Debug("order changed before the warehouse could move it")
+			return
+		}
+
+		logger.WithError(err).Error("failed to move order through the warehouse")
+		return
+	}
+
+	logger.Debug("warehouse moved order")
+}
@@ -0,0 +1,119 @@
+package orders_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+const warehouseDelay = 20 * time.Millisecond
+
+func TestWarehouseDeliversPlacedOrders(t *testing.T) {
+	ctx, service, store := runWarehouse(t)
+
+	order, err := service.PlaceOrder(ctx, orders.DefaultTenantID, "customer", "product", "address", "payment")
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+
+	delivered := waitForStatus(t, store, order, orders.OrderStatusDelivered)
+
+	var statuses []string
+	for _, entry := range delivered.DeliveryEntries {
+		statuses = append(statuses, entry.Message)
+	}
+	want := []string{"Order has been placed", "Order is in transit", "Order has been delivered"}
+	if len(statuses) != len(want) {
+		t.Fatalf("delivered order has delivery entries %q, want %q", statuses, want)
+	}
+	for i := range want {
+		if statuses[i] != want[i] {
+			t.Errorf("delivery entry %d is %q, want %q", i, statuses[i], want[i])
+		}
+	}
+
+	if delivered.DeliveredAt.IsZero() || delivered.Version != 3 {
+		t.Errorf("delivered order has DeliveredAt %s and version %d, want it set and version 3", delivered.DeliveredAt, delivered.Version)
+	}
+}
+
+func TestWarehouseLeavesHeldOrders(t *testing.T) {
+	ctx, service, store := runWarehouse(t)
+
+	order, err := service.PlaceOrder(ctx, orders.DefaultTenantID, "customer", "product", "address", "payment")
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+
+	held, err := service.HoldOrder(ctx, order.TenantID, order.OrderID, "checking the address", 0)
+	if err != nil {
+		t.Fatalf("HoldOrder returned error: %v", err)
+	}
+
+	time.Sleep(5 * warehouseDelay)
+	got := getOrder(t, store, order)
+	if got.Status != orders.OrderStatusOnHold || got.Version != held.Version {
+		t.Fatalf("held order is %s at version %d, want it left %s at version %d", got.Status, got.Version, orders.OrderStatusOnHold, held.Version)
+	}
+
+	// Releasing the hold places the order again, after which the warehouse picks it back up.
+	if _, err := service.SetStatus(ctx, order.TenantID, order.OrderID, orders.OrderStatusPlaced, "", 0); err != nil {
+		t.Fatalf("SetStatus returned error: %v", err)
+	}
+	waitForStatus(t, store, order, orders.OrderStatusDelivered)
+}
+
+// runWarehouse returns a service, and the store behind it, whose orders are moved along by a
+// warehouse that runs until the test ends.
+func runWarehouse(t *testing.T) (context.Context, *orders.Service, orders.OrderRepository) {
+	t.Helper()
+
+	logger := logging.NewNoopLogger()
+	warehouse := orders.NewWarehouse(warehouseDelay, logger)
+	store := orders.NewOrderStore()
+	service := orders.NewService(store, warehouse, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		warehouse.Run(ctx, service)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return ctx, service, store
+}
+
+func waitForStatus(t *testing.T, store orders.OrderRepository, order orders.Order, status orders.OrderStatus) orders.Order {
+	t.Helper()
+
+	deadline := time.Now().Add(100 * warehouseDelay)
+	for {
+		got := getOrder(t, store, order)
+		if got.Status == status {
+			return got
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("order is still %s, want %s", got.Status, status)
+		}
+		time.Sleep(warehouseDelay / 4)
+	}
+}
+
+func getOrder(t *testing.T, store orders.OrderRepository, order orders.Order) orders.Order {
+	t.Helper()
+
+	got, err := store.GetOrderByID(context.Background(), order.TenantID, order.OrderID)
+	if err != nil {
+		t.Fatalf("GetOrderByID returned error: %v", err)
+	}
+
+	return got
+}
@@ -0,0 +1,3 @@
+DROP TABLE delivery_entries;
+
+DROP TABLE orders;
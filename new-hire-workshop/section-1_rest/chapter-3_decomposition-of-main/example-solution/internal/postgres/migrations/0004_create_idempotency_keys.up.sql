@@ -0,0 +1,12 @@
+CREATE TABLE idempotency_keys (
+    customer_id     TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    fingerprint     TEXT NOT NULL,
+    expires_at      TIMESTAMPTZ NOT NULL,
+    status_code     INTEGER NULL,
+    content_type    TEXT NULL,
+    body            BYTEA NULL,
+    PRIMARY KEY (customer_id, idempotency_key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,33 @@
+-- Without the tenant columns, the data of other tenants would be mixed in with the default
+-- tenant's, so the migration refuses to run while there is any rather than merging or deleting it.
+-- It has to be moved out of the database first.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM orders WHERE tenant_id <> 'default')
+        OR EXISTS (SELECT 1 FROM webhook_subscriptions WHERE tenant_id <> 'default')
+        OR EXISTS (SELECT 1 FROM webhook_deliveries WHERE tenant_id <> 'default')
+        OR EXISTS (SELECT 1 FROM idempotency_keys WHERE tenant_id <> 'default') THEN
+        RAISE EXCEPTION 'cannot remove tenants while there is data in tenants other than default';
+    END IF;
+END
+$$;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (customer_id, idempotency_key);
+
+ALTER TABLE idempotency_keys DROP COLUMN tenant_id;
+
+ALTER TABLE webhook_deliveries DROP COLUMN tenant_id;
+
+CREATE INDEX webhook_subscriptions_customer_id_idx ON webhook_subscriptions (customer_id);
+
+DROP INDEX webhook_subscriptions_tenant_customer_idx;
+
+ALTER TABLE webhook_subscriptions DROP COLUMN tenant_id;
+
+CREATE INDEX orders_customer_ordered_at_idx ON orders (customer_id, ordered_at, order_id);
+
+DROP INDEX orders_tenant_customer_ordered_at_idx;
+
+ALTER TABLE orders DROP COLUMN tenant_id;
@@ -0,0 +1,19 @@
+ALTER TABLE orders ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX orders_tenant_customer_ordered_at_idx ON orders (tenant_id, customer_id, ordered_at, order_id);
+
+DROP INDEX orders_customer_ordered_at_idx;
+
+ALTER TABLE webhook_subscriptions ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX webhook_subscriptions_tenant_customer_idx ON webhook_subscriptions (tenant_id, customer_id);
+
+DROP INDEX webhook_subscriptions_customer_id_idx;
+
+ALTER TABLE webhook_deliveries ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE idempotency_keys ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (tenant_id, customer_id, idempotency_key);
@@ -0,0 +1,48 @@
+// Package postgres connects the order service to a PostgreSQL database, and owns the schema
+// migrations for that database.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	// Registers the "pgx" driver with database/sql.
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Open connects to the PostgreSQL database described by dsn, checking the connection first.
+func Open(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening postgres connection: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connecting to postgres: %w", err)
+	}
+
+	return db, nil
+}
+
+// NewMigrator creates a migrator for the order service's PostgreSQL schema.
+func NewMigrator(db *sql.DB) (*migrate.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewMigrator(db, migrations), nil
+}
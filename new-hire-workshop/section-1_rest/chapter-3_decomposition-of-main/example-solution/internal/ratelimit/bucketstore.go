@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BucketStore is an in-memory implementation of BucketRepository. It is safe for concurrent use
+// by multiple goroutines.
+type BucketStore struct {
+	mu      sync.Mutex
+	buckets map[string]storedBucket
+	// nextSweep is when the full buckets are next removed.
+	nextSweep time.Time
+}
+
+// sweepInterval is how often a BucketStore removes full buckets.
+const sweepInterval = time.Minute
+
+// storedBucket is a bucket along with when it will be full, which is kept so that the store does
+// not need to know the limit of every bucket to tell which ones it can remove.
+type storedBucket struct {
+	bucket Bucket
+	fullAt time.Time
+}
+
+var _ BucketRepository = (*BucketStore)(nil)
+
+func NewBucketStore() *BucketStore {
+	return &BucketStore{
+		buckets: map[string]storedBucket{},
+	}
+}
+
+func (s *BucketStore) Take(_ context.Context, key string, limit Limit) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if !now.Before(s.nextSweep) {
+		s.sweep(now)
+	}
+
+	bucket, decision := s.buckets[key].bucket.Take(limit, now)
+	s.buckets[key] = storedBucket{bucket: bucket, fullAt: bucket.FullAt(limit)}
+	return decision, nil
+}
+
+func (s *BucketStore) Check(_ context.Context, key string, limit Limit) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.buckets[key].bucket.Check(limit, time.Now()), nil
+}
+
+// sweep removes the buckets that are full at now, which are no different from the new buckets
+// that take their place. The caller must hold the mutex.
+func (s *BucketStore) sweep(now time.Time) {
+	for key, stored := range s.buckets {
+		if !stored.fullAt.After(now) {
+			delete(s.buckets, key)
+		}
+	}
+
+	s.nextSweep = now.Add(sweepInterval)
+}
@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBucketStoreSweepsFullBuckets(t *testing.T) {
+	store := NewBucketStore()
+	ctx := context.Background()
+	short := Limit{Requests: 1000, Per: time.Millisecond}
+	long := Limit{Requests: 1, Per: time.Hour}
+
+	for _, key := range []string{"a", "b", "c"} {
+		if _, err := store.Take(ctx, key, short); err != nil {
+			t.Fatalf("Take returned error: %v", err)
+		}
+	}
+	if _, err := store.Take(ctx, "long", long); err != nil {
+		t.Fatalf("Take returned error: %v", err)
+	}
+
+	// The short buckets fill up again straight away, but are kept until the next sweep.
+	time.Sleep(10 * time.Millisecond)
+	if _, err := store.Take(ctx, "d", long); err != nil {
+		t.Fatalf("Take returned error: %v", err)
+	}
+	if got := bucketCount(store); got != 5 {
+		t.Fatalf("store holds %d buckets before the sweep, want 5", got)
+	}
+
+	store.mu.Lock()
+	store.nextSweep = time.Time{}
+	store.mu.Unlock()
+
+	if _, err := store.Take(ctx, "e", long); err != nil {
+		t.Fatalf("Take returned error: %v", err)
+	}
+	if got := bucketCount(store); got != 3 {
+		t.Errorf("store holds %d buckets after the sweep, want only the 3 that are not full", got)
+	}
+
+	// A bucket that was kept is still used, rather than being replaced with a full one.
+	decision, err := store.Take(ctx, "long", long)
+	if err != nil {
+		t.Fatalf("Take returned error: %v", err)
+	}
+	if decision.Allowed {
+		t.Error("Take allowed a second request from a bucket that holds one")
+	}
+}
+
+func bucketCount(store *BucketStore) int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return len(store.buckets)
+}
@@ -0,0 +1,165 @@
+// Package ratelimit limits how often clients can make requests, with a token bucket for each
+// client that is refilled at a steady rate and that every request takes a token from.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is how many requests a client can make in a window of time.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// ParseLimit parses a limit written as the number of requests and the window they are allowed in,
+// such as 100/1m.
+func ParseLimit(s string) (Limit, error) {
+	requests, per, ok := strings.Cut(s, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate limit %q is not of the form requests/window", s)
+	}
+
+	limit := Limit{}
+	var err error
+	if limit.Requests, err = strconv.Atoi(strings.TrimSpace(requests)); err != nil {
+		return Limit{}, fmt.Errorf("rate limit %q has an invalid number of requests: %w", s, err)
+	}
+
+	if limit.Per, err = time.ParseDuration(strings.TrimSpace(per)); err != nil {
+		return Limit{}, fmt.Errorf("rate limit %q has an invalid window: %w", s, err)
+	}
+
+	if err := limit.Validate(); err != nil {
+		return Limit{}, fmt.Errorf("rate limit %q is not valid: %w", s, err)
+	}
+
+	return limit, nil
+}
+
+// Validate checks that the limit allows at least one request in a window of some length.
+func (l Limit) Validate() error {
+	if l.Requests < 1 {
+		return errors.New("at least one request must be allowed")
+	}
+
+	if l.Per <= 0 {
+		return errors.New("the window must be positive")
+	}
+
+	return nil
+}
+
+// String formats the limit as ParseLimit parses it, leaving off the zero units at the end of
+// the window, so that a limit of 100 requests a minute is 100/1m rather than 100/1m0s.
+func (l Limit) String() string {
+	per := l.Per.String()
+	if strings.HasSuffix(per, "m0s") {
+		per = strings.TrimSuffix(per, "0s")
+	}
+	if strings.HasSuffix(per, "h0m") {
+		per = strings.TrimSuffix(per, "0m")
+	}
+
+	return fmt.Sprintf("%d/%s", l.Requests, per)
+}
+
+// rate is how many tokens are added to a bucket each second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+// Bucket is the state of a client's token bucket. The tokens that have been added since it was
+// last updated are only counted when a request takes one, so nothing has to refill it.
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Decision is the outcome of a request taking a token from its bucket.
+type Decision struct {
+	Allowed bool
+	Limit   Limit
+	// Remaining is how many more requests can be made straight away.
+	Remaining int
+	// Reset is how long it will take for the bucket to be full again.
+	Reset time.Duration
+	// RetryAfter is how long to wait before the bucket has a token for another request. It is only
+	// set for requests that are not allowed.
+	RetryAfter time.Duration
+}
+
+// Take takes a token from the bucket for a request made at now, if it has one. A bucket that has
+// never been used is full.
+func (b Bucket) Take(limit Limit, now time.Time) (Bucket, Decision) {
+	tokens := b.tokensAt(limit, now)
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+
+	return Bucket{Tokens: tokens, UpdatedAt: now}, decide(limit, tokens, allowed)
+}
+
+// Check reports whether the bucket has a token for a request made at now, without taking it.
+func (b Bucket) Check(limit Limit, now time.Time) Decision {
+	tokens := b.tokensAt(limit, now)
+	return decide(limit, tokens, tokens >= 1)
+}
+
+// tokensAt returns how many tokens the bucket holds at now, counting the ones added since it was
+// last updated.
+func (b Bucket) tokensAt(limit Limit, now time.Time) float64 {
+	capacity := float64(limit.Requests)
+	if b.UpdatedAt.IsZero() {
+		return capacity
+	}
+
+	elapsed := now.Sub(b.UpdatedAt).Seconds()
+	if elapsed < 0 {
+		// The clocks of servers sharing a bucket may not quite agree.
+		elapsed = 0
+	}
+
+	return math.Min(capacity, b.Tokens+elapsed*limit.rate())
+}
+
+// decide describes a bucket that holds tokens once a request has been allowed or not.
+func decide(limit Limit, tokens float64, allowed bool) Decision {
+	decision := Decision{Limit: limit, Allowed: allowed}
+	if !allowed {
+		decision.RetryAfter = secondsToDuration((1 - tokens) / limit.rate())
+	}
+
+	decision.Remaining = int(math.Floor(tokens))
+	decision.Reset = secondsToDuration((float64(limit.Requests) - tokens) / limit.rate())
+
+	return decision
+}
+
+// FullAt returns when the bucket will be full again, after which it is no different to a bucket
+// that has never been used, and so does not need to be kept.
+func (b Bucket) FullAt(limit Limit) time.Time {
+	return b.UpdatedAt.Add(secondsToDuration((float64(limit.Requests) - b.Tokens) / limit.rate()))
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
+
+// BucketRepository is the access point for the token buckets of clients. The buckets are
+// identified by a key that says who the client is and which limit the bucket is for.
+type BucketRepository interface {
+	// Take takes a token from the bucket with the key, as Bucket.Take does, and stores the bucket
+	// that it leaves behind. Concurrent requests with the same key must not take the same token.
+	Take(ctx context.Context, key string, limit Limit) (Decision, error)
+	// Check reports whether the bucket with the key has a token, as Bucket.Check does, without
+	// taking it.
+	Check(ctx context.Context, key string, limit Limit) (Decision, error)
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketTake(t *testing.T) {
+	limit := Limit{Requests: 2, Per: time.Minute}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		bucket   Bucket
+		at       time.Time
+		want     Decision
+		wantLeft float64
+	}{
+		{
+			name:     "new bucket is full",
+			at:       start,
+			want:     Decision{Allowed: true, Limit: limit, Remaining: 1, Reset: 30 * time.Second},
+			wantLeft: 1,
+		},
+		{
+			name:     "empty bucket",
+			bucket:   Bucket{Tokens: 0, UpdatedAt: start},
+			at:       start,
+			want:     Decision{Allowed: false, Limit: limit, Remaining: 0, Reset: time.Minute, RetryAfter: 30 * time.Second},
+			wantLeft: 0,
+		},
+		{
+			name:     "partly refilled bucket",
+			bucket:   Bucket{Tokens: 0, UpdatedAt: start},
+			at:       start.Add(15 * time.Second),
+			want:     Decision{Allowed: false, Limit: limit, Remaining: 0, Reset: 45 * time.Second, RetryAfter: 15 * time.Second},
+			wantLeft: 0.5,
+		},
+		{
+			name:     "refilled token",
+			bucket:   Bucket{Tokens: 0.5, UpdatedAt: start},
+			at:       start.Add(15 * time.Second),
+			want:     Decision{Allowed: true, Limit: limit, Remaining: 0, Reset: time.Minute},
+			wantLeft: 0,
+		},
+		{
+			name:     "refill stops when full",
+			bucket:   Bucket{Tokens: 1, UpdatedAt: start},
+			at:       start.Add(time.Hour),
+			want:     Decision{Allowed: true, Limit: limit, Remaining: 1, Reset: 30 * time.Second},
+			wantLeft: 1,
+		},
+		{
+			name:     "clock behind the last update",
+			bucket:   Bucket{Tokens: 1, UpdatedAt: start},
+			at:       start.Add(-time.Minute),
+			want:     Decision{Allowed: true, Limit: limit, Remaining: 0, Reset: time.Minute},
+			wantLeft: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checked := tt.bucket.Check(limit, tt.at)
+			bucket, decision := tt.bucket.Take(limit, tt.at)
+			if decision != tt.want {
+				t.Errorf("Take decided %+v, want %+v", decision, tt.want)
+			}
+			if bucket.Tokens != tt.wantLeft || !bucket.UpdatedAt.Equal(tt.at) {
+				t.Errorf("Take left %v tokens at %s, want %v at %s", bucket.Tokens, bucket.UpdatedAt, tt.wantLeft, tt.at)
+			}
+			if checked.Allowed != tt.want.Allowed {
+				t.Errorf("Check allowed %t, want %t as Take does", checked.Allowed, tt.want.Allowed)
+			}
+		})
+	}
+}
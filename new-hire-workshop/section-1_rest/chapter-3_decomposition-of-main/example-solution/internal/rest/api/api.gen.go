@@ -0,0 +1,6102 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.13.4 DO NOT EDIT.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/gorilla/mux"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for OrderStatus.
+const (
+	OrderStatusDelivered OrderStatus = "Delivered"
+	OrderStatusInTransit OrderStatus = "In transit"
+	OrderStatusPlaced    OrderStatus = "Placed"
+)
+
+// Defines values for OrderStatusV2.
+const (
+	OrderStatusV2Cancelled OrderStatusV2 = "Cancelled"
+	OrderStatusV2Delivered OrderStatusV2 = "Delivered"
+	OrderStatusV2InTransit OrderStatusV2 = "In transit"
+	OrderStatusV2OnHold    OrderStatusV2 = "On hold"
+	OrderStatusV2Placed    OrderStatusV2 = "Placed"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "Dead"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "Succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeOrderCancelled     WebhookEventType = "order.cancelled"
+	WebhookEventTypeOrderPlaced        WebhookEventType = "order.placed"
+	WebhookEventTypeOrderStatusChanged WebhookEventType = "order.status_changed"
+)
+
+// Defines values for Sort.
+const (
+	SortMinusOrderedAt Sort = "-orderedAt"
+	SortOrderedAt      Sort = "orderedAt"
+)
+
+// Defines values for ListOrdersParamsSort.
+const (
+	ListOrdersParamsSortMinusOrderedAt ListOrdersParamsSort = "-orderedAt"
+	ListOrdersParamsSortOrderedAt      ListOrdersParamsSort = "orderedAt"
+)
+
+// Defines values for ListOrdersV2ParamsSort.
+const (
+	MinusOrderedAt ListOrdersV2ParamsSort = "-orderedAt"
+	OrderedAt      ListOrdersV2ParamsSort = "orderedAt"
+)
+
+// CancelOrderRequest defines model for CancelOrderRequest.
+type CancelOrderRequest struct {
+	// Reason Why the customer is cancelling the order
+	Reason *string `json:"reason,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Url The http or https URL to send the events to
+	Url string `json:"url"`
+}
+
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Secret The key that the requests sent to the webhook are signed with
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// DeliveryEntry defines model for DeliveryEntry.
+type DeliveryEntry struct {
+	// Message Description of event
+	Message string `json:"message"`
+
+	// Timestamp When the event occurred
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Message The error message
+	Message string `json:"message"`
+}
+
+// HoldOrderRequest defines model for HoldOrderRequest.
+type HoldOrderRequest struct {
+	// Reason Why the order is being held, which the customer is shown
+	Reason *string `json:"reason,omitempty"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// Name The name of the rejected input
+	Name string `json:"name"`
+
+	// Reason Why the input was rejected
+	Reason string `json:"reason"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// DeliveredAt When the order was delivered to the delivery address
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	// DeliveryEntries A list of the delivery events that have occured so far for the order
+	DeliveryEntries []DeliveryEntry `json:"deliveryEntries"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// OrderedAt When the order was placed
+	OrderedAt time.Time `json:"orderedAt"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order
+	Status OrderStatus `json:"status"`
+}
+
+// OrderStatus Summary of the current status of the order
+type OrderStatus string
+
+// OrderStatusEvent defines model for OrderStatusEvent.
+type OrderStatusEvent struct {
+	// Reason The reason given for the status, such as why the order was cancelled
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+}
+
+// OrderStatusV2 Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+// are reported as such rather than as placed.
+type OrderStatusV2 string
+
+// OrderSummary defines model for OrderSummary.
+type OrderSummary struct {
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order
+	Status OrderStatus `json:"status"`
+}
+
+// OrderSummaryList defines model for OrderSummaryList.
+type OrderSummaryList struct {
+	// NextPageToken Pass as pageToken to fetch the next page. Omitted on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// OrderSummaries Array of the order summaries
+	OrderSummaries []OrderSummary `json:"orderSummaries"`
+}
+
+// OrderSummaryListV2 defines model for OrderSummaryListV2.
+type OrderSummaryListV2 struct {
+	// NextPageToken Pass as pageToken to fetch the next page. Omitted on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// OrderSummaries Array of the order summaries
+	OrderSummaries []OrderSummaryV2 `json:"orderSummaries"`
+}
+
+// OrderSummaryV2 defines model for OrderSummaryV2.
+type OrderSummaryV2 struct {
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+
+	// StatusReason Why the order moved into its current status, if a reason was given
+	StatusReason *string `json:"statusReason,omitempty"`
+}
+
+// OrderV2 defines model for OrderV2.
+type OrderV2 struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// DeliveredAt When the order was delivered to the delivery address
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	// DeliveryEntries A list of the delivery events that have occured so far for the order
+	DeliveryEntries []DeliveryEntry `json:"deliveryEntries"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// OrderedAt When the order was placed
+	OrderedAt time.Time `json:"orderedAt"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+
+	// StatusReason Why the order moved into its current status, if a reason was given
+	StatusReason *string `json:"statusReason,omitempty"`
+}
+
+// PlaceOrderRequest defines model for PlaceOrderRequest.
+type PlaceOrderRequest struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product to order
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+// Problem An RFC 7807 problem details error. It is returned instead of Error when the client accepts
+// application/problem+json, and by default from /api/v2.
+type Problem struct {
+	// Detail An explanation of this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance The path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams The inputs that were rejected, when the request was invalid
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+
+	// Status The HTTP status code of the response
+	Status int `json:"status"`
+
+	// Title A short summary of the problem type
+	Title string `json:"title"`
+
+	// Type A URI reference that identifies the problem type
+	Type string `json:"type"`
+}
+
+// SetOrderStatusRequest defines model for SetOrderStatusRequest.
+type SetOrderStatusRequest struct {
+	// Reason Why the order's status is being changed, which the customer is shown
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Url       string             `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The attempts made since the delivery was created or last replayed
+	Attempts  int                `json:"attempts"`
+	CreatedAt time.Time          `json:"createdAt"`
+	EventId   openapi_types.UUID `json:"eventId"`
+	EventType WebhookEventType   `json:"eventType"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError Why the last attempt failed
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode The status the webhook responded to the last attempt with
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt When a pending delivery will next be tried
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Status Whether the delivery is still being tried, was accepted by the webhook, or failed too many
+	// times and was dead-lettered.
+	Status    WebhookDeliveryStatus `json:"status"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookDeliveryStatus Whether the delivery is still being tried, was accepted by the webhook, or failed too many
+// times and was dead-lettered.
+type WebhookDeliveryStatus string
+
+// WebhookEvent The body of the requests sent to webhooks.
+type WebhookEvent struct {
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// Id The ID of the event, which is the same for every webhook it is sent to
+	Id         openapi_types.UUID `json:"id"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	OrderId    openapi_types.UUID `json:"orderId"`
+
+	// Reason The reason given for the status, such as why the order was cancelled
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2    `json:"status"`
+	Type   WebhookEventType `json:"type"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// CustomerID defines model for CustomerID.
+type CustomerID = openapi_types.UUID
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// LastEventID defines model for LastEventID.
+type LastEventID = int
+
+// Limit defines model for Limit.
+type Limit = int
+
+// OrderedBefore defines model for OrderedBefore.
+type OrderedBefore = time.Time
+
+// OrderedFrom defines model for OrderedFrom.
+type OrderedFrom = time.Time
+
+// PageToken defines model for PageToken.
+type PageToken = string
+
+// RequestID defines model for RequestID.
+type RequestID = string
+
+// Sort defines model for Sort.
+type Sort string
+
+// TenantID defines model for TenantID.
+type TenantID = string
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// ListOrdersParams defines parameters for ListOrders.
+type ListOrdersParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// CustomerId The customer whose orders to list, if it is not the user making the request. Only support
+	// staff and admins may list other customers' orders.
+	CustomerId *CustomerID `form:"customerId,omitempty" json:"customerId,omitempty"`
+
+	// Limit The maximum number of order summaries to return in one page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PageToken The nextPageToken returned by a previous call. The other list parameters must be the same as
+	// in the call that returned the token.
+	PageToken *PageToken `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// Status Only return orders in one of the given statuses. May be repeated.
+	Status *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrderedFrom Only return orders placed at or after this time
+	OrderedFrom *OrderedFrom `form:"orderedFrom,omitempty" json:"orderedFrom,omitempty"`
+
+	// OrderedBefore Only return orders placed before this time
+	OrderedBefore *OrderedBefore `form:"orderedBefore,omitempty" json:"orderedBefore,omitempty"`
+
+	// Sort Sort by order time, oldest first, or newest first when prefixed with '-'
+	Sort *ListOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListOrdersParamsSort defines parameters for ListOrders.
+type ListOrdersParamsSort string
+
+// PlaceOrderParams defines parameters for PlaceOrder.
+type PlaceOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IdempotencyKey A unique key chosen by the client, such as a UUID, so that the request can be safely
+	// retried. A retry with the same key and body gets the response to the first request, with
+	// an Idempotent-Replayed header, rather than placing another order. Keys are scoped to the
+	// user and are kept for 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetOrderParams defines parameters for GetOrder.
+type GetOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetOrderEventsParams defines parameters for GetOrderEvents.
+type GetOrderEventsParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// LastEventID The ID of the last event the client received from an earlier stream, so that the stream
+	// can carry on from there.
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// CancelOrderParams defines parameters for CancelOrder.
+type CancelOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ReplayWebhookDeliveriesParams defines parameters for ReplayWebhookDeliveries.
+type ReplayWebhookDeliveriesParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ListOrdersV2Params defines parameters for ListOrdersV2.
+type ListOrdersV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// CustomerId The customer whose orders to list, if it is not the user making the request. Only support
+	// staff and admins may list other customers' orders.
+	CustomerId *CustomerID `form:"customerId,omitempty" json:"customerId,omitempty"`
+
+	// Limit The maximum number of order summaries to return in one page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PageToken The nextPageToken returned by a previous call. The other list parameters must be the same as
+	// in the call that returned the token.
+	PageToken *PageToken `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// Status Only return orders in one of the given statuses. May be repeated.
+	Status *[]OrderStatusV2 `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrderedFrom Only return orders placed at or after this time
+	OrderedFrom *OrderedFrom `form:"orderedFrom,omitempty" json:"orderedFrom,omitempty"`
+
+	// OrderedBefore Only return orders placed before this time
+	OrderedBefore *OrderedBefore `form:"orderedBefore,omitempty" json:"orderedBefore,omitempty"`
+
+	// Sort Sort by order time, oldest first, or newest first when prefixed with '-'
+	Sort *ListOrdersV2ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListOrdersV2ParamsSort defines parameters for ListOrdersV2.
+type ListOrdersV2ParamsSort string
+
+// PlaceOrderV2Params defines parameters for PlaceOrderV2.
+type PlaceOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IdempotencyKey A unique key chosen by the client, such as a UUID, so that the request can be safely
+	// retried. A retry with the same key and body gets the response to the first request, with
+	// an Idempotent-Replayed header, rather than placing another order. Keys are scoped to the
+	// user and are kept for 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetOrderV2Params defines parameters for GetOrderV2.
+type GetOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// CancelOrderV2Params defines parameters for CancelOrderV2.
+type CancelOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// HoldOrderParams defines parameters for HoldOrder.
+type HoldOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SetOrderStatusParams defines parameters for SetOrderStatus.
+type SetOrderStatusParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PlaceOrderJSONRequestBody defines body for PlaceOrder for application/json ContentType.
+type PlaceOrderJSONRequestBody = PlaceOrderRequest
+
+// CancelOrderJSONRequestBody defines body for CancelOrder for application/json ContentType.
+type CancelOrderJSONRequestBody = CancelOrderRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// PlaceOrderV2JSONRequestBody defines body for PlaceOrderV2 for application/json ContentType.
+type PlaceOrderV2JSONRequestBody = PlaceOrderRequest
+
+// CancelOrderV2JSONRequestBody defines body for CancelOrderV2 for application/json ContentType.
+type CancelOrderV2JSONRequestBody = CancelOrderRequest
+
+// HoldOrderJSONRequestBody defines body for HoldOrder for application/json ContentType.
+type HoldOrderJSONRequestBody = HoldOrderRequest
+
+// SetOrderStatusJSONRequestBody defines body for SetOrderStatus for application/json ContentType.
+type SetOrderStatusJSONRequestBody = SetOrderStatusRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List a user's orders
+	// (GET /api/v1/orders)
+	ListOrders(w http.ResponseWriter, r *http.Request, params ListOrdersParams)
+	// Place a new order
+	// (POST /api/v1/orders)
+	PlaceOrder(w http.ResponseWriter, r *http.Request, params PlaceOrderParams)
+	// Returns details of a specific order
+	// (GET /api/v1/orders/{orderid})
+	GetOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderParams)
+	// Stream changes to an order
+	// (GET /api/v1/orders/{orderid}/events)
+	GetOrderEvents(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderEventsParams)
+	// Cancel an order
+	// (POST /api/v1/orders/{orderid}:cancel)
+	CancelOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params CancelOrderParams)
+	// Returns the user's webhooks
+	// (GET /api/v1/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams)
+	// Register a webhook
+	// (POST /api/v1/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request, params CreateWebhookParams)
+	// Delete a webhook
+	// (DELETE /api/v1/webhooks/{webhookid})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params DeleteWebhookParams)
+	// Returns a webhook's delivery log
+	// (GET /api/v1/webhooks/{webhookid}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// Replay a webhook's dead-lettered deliveries
+	// (POST /api/v1/webhooks/{webhookid}:replay)
+	ReplayWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params ReplayWebhookDeliveriesParams)
+	// List a user's orders (v2)
+	// (GET /api/v2/orders)
+	ListOrdersV2(w http.ResponseWriter, r *http.Request, params ListOrdersV2Params)
+	// Place a new order
+	// (POST /api/v2/orders)
+	PlaceOrderV2(w http.ResponseWriter, r *http.Request, params PlaceOrderV2Params)
+	// Returns details of a specific order
+	// (GET /api/v2/orders/{orderid})
+	GetOrderV2(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderV2Params)
+	// Cancel an order
+	// (POST /api/v2/orders/{orderid}:cancel)
+	CancelOrderV2(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params CancelOrderV2Params)
+	// Put an order on hold
+	// (POST /api/v2/orders/{orderid}:hold)
+	HoldOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params HoldOrderParams)
+	// Change an order's status
+	// (POST /api/v2/orders/{orderid}:setStatus)
+	SetOrderStatus(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params SetOrderStatusParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// ListOrders operation middleware
+func (siw *ServerInterfaceWrapper) ListOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrdersParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "customerId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "customerId", r.URL.Query(), &params.CustomerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageToken", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderedFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderedFrom", r.URL.Query(), &params.OrderedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderedFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderedBefore", r.URL.Query(), &params.OrderedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrders(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PlaceOrder operation middleware
+func (siw *ServerInterfaceWrapper) PlaceOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlaceOrderParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceOrder(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrder(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOrderEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderEventsParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrderEvents(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CancelOrder operation middleware
+func (siw *ServerInterfaceWrapper) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelOrderParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelOrder(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateWebhookParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "webhookid" -------------
+	var webhookid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "webhookid", mux.Vars(r)["webhookid"], &webhookid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhookParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "webhookid" -------------
+	var webhookid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "webhookid", mux.Vars(r)["webhookid"], &webhookid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, webhookid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReplayWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ReplayWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "webhookid" -------------
+	var webhookid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "webhookid", mux.Vars(r)["webhookid"], &webhookid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplayWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayWebhookDeliveries(w, r, webhookid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListOrdersV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListOrdersV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrdersV2Params
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "customerId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "customerId", r.URL.Query(), &params.CustomerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageToken", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderedFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderedFrom", r.URL.Query(), &params.OrderedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderedFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderedBefore", r.URL.Query(), &params.OrderedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrdersV2(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PlaceOrderV2 operation middleware
+func (siw *ServerInterfaceWrapper) PlaceOrderV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlaceOrderV2Params
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceOrderV2(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOrderV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderV2Params
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrderV2(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CancelOrderV2 operation middleware
+func (siw *ServerInterfaceWrapper) CancelOrderV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelOrderV2Params
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelOrderV2(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// HoldOrder operation middleware
+func (siw *ServerInterfaceWrapper) HoldOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldOrderParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HoldOrder(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetOrderStatus operation middleware
+func (siw *ServerInterfaceWrapper) SetOrderStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderid" -------------
+	var orderid openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "orderid", mux.Vars(r)["orderid"], &orderid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetOrderStatusParams
+
+	// ------------- Optional query parameter "userid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userid", r.URL.Query(), &params.Userid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userid", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, valueList[0], &XTenantID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID RequestID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-ID", Err: err})
+			return
+		}
+
+		params.XRequestID = &XRequestID
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetOrderStatus(w, r, orderid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/api/v1/orders", wrapper.ListOrders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/orders", wrapper.PlaceOrder).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/orders/{orderid}", wrapper.GetOrder).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/orders/{orderid}/events", wrapper.GetOrderEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/orders/{orderid}:cancel", wrapper.CancelOrder).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/webhooks", wrapper.ListWebhooks).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/webhooks", wrapper.CreateWebhook).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/webhooks/{webhookid}", wrapper.DeleteWebhook).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/webhooks/{webhookid}/deliveries", wrapper.ListWebhookDeliveries).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v1/webhooks/{webhookid}:replay", wrapper.ReplayWebhookDeliveries).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders", wrapper.ListOrdersV2).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders", wrapper.PlaceOrderV2).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders/{orderid}", wrapper.GetOrderV2).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders/{orderid}:cancel", wrapper.CancelOrderV2).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders/{orderid}:hold", wrapper.HoldOrder).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/v2/orders/{orderid}:setStatus", wrapper.SetOrderStatus).Methods("POST")
+
+	return r
+}
+
+type ListOrdersRequestObject struct {
+	Params ListOrdersParams
+}
+
+type ListOrdersResponseObject interface {
+	VisitListOrdersResponse(w http.ResponseWriter) error
+}
+
+type ListOrders200ResponseHeaders struct {
+	ETag string
+}
+
+type ListOrders200JSONResponse struct {
+	Body    OrderSummaryList
+	Headers ListOrders200ResponseHeaders
+}
+
+func (response ListOrders200JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders304ResponseHeaders struct {
+	ETag string
+}
+
+type ListOrders304Response struct {
+	Headers ListOrders304ResponseHeaders
+}
+
+func (response ListOrders304Response) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type ListOrders400JSONResponse Error
+
+func (response ListOrders400JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrders400ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type ListOrders401JSONResponse struct {
+	Body    Error
+	Headers ListOrders401ResponseHeaders
+}
+
+func (response ListOrders401JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListOrders401ResponseHeaders
+}
+
+func (response ListOrders401ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders403JSONResponse Error
+
+func (response ListOrders403JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders403ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrders403ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type ListOrders429JSONResponse struct {
+	Body    Error
+	Headers ListOrders429ResponseHeaders
+}
+
+func (response ListOrders429JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListOrders429ResponseHeaders
+}
+
+func (response ListOrders429ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrders500JSONResponse Error
+
+func (response ListOrders500JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrders500ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders503JSONResponse Error
+
+func (response ListOrders503JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders503ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrders503ApplicationProblemPlusJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderRequestObject struct {
+	Params PlaceOrderParams
+	Body   *PlaceOrderJSONRequestBody
+}
+
+type PlaceOrderResponseObject interface {
+	VisitPlaceOrderResponse(w http.ResponseWriter) error
+}
+
+type PlaceOrder200JSONResponse OrderSummary
+
+func (response PlaceOrder200JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder400JSONResponse Error
+
+func (response PlaceOrder400JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder400ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder400ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type PlaceOrder401JSONResponse struct {
+	Body    Error
+	Headers PlaceOrder401ResponseHeaders
+}
+
+func (response PlaceOrder401JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrder401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers PlaceOrder401ResponseHeaders
+}
+
+func (response PlaceOrder401ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrder403JSONResponse Error
+
+func (response PlaceOrder403JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder403ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder403ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder409JSONResponse Error
+
+func (response PlaceOrder409JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder409ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder409ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder422JSONResponse Error
+
+func (response PlaceOrder422JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder422ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder422ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type PlaceOrder429JSONResponse struct {
+	Body    Error
+	Headers PlaceOrder429ResponseHeaders
+}
+
+func (response PlaceOrder429JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrder429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers PlaceOrder429ResponseHeaders
+}
+
+func (response PlaceOrder429ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrder500JSONResponse Error
+
+func (response PlaceOrder500JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder500ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder500ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder503JSONResponse Error
+
+func (response PlaceOrder503JSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrder503ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrder503ApplicationProblemPlusJSONResponse) VisitPlaceOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderRequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  GetOrderParams
+}
+
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(w http.ResponseWriter) error
+}
+
+type GetOrder200ResponseHeaders struct {
+	ETag string
+}
+
+type GetOrder200JSONResponse struct {
+	Body    Order
+	Headers GetOrder200ResponseHeaders
+}
+
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrder304ResponseHeaders struct {
+	ETag string
+}
+
+type GetOrder304Response struct {
+	Headers GetOrder304ResponseHeaders
+}
+
+func (response GetOrder304Response) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetOrder400JSONResponse Error
+
+func (response GetOrder400JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrder400ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type GetOrder401JSONResponse struct {
+	Body    Error
+	Headers GetOrder401ResponseHeaders
+}
+
+func (response GetOrder401JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrder401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrder401ResponseHeaders
+}
+
+func (response GetOrder401ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrder403JSONResponse Error
+
+func (response GetOrder403JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrder403ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder404JSONResponse Error
+
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrder404ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type GetOrder429JSONResponse struct {
+	Body    Error
+	Headers GetOrder429ResponseHeaders
+}
+
+func (response GetOrder429JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrder429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrder429ResponseHeaders
+}
+
+func (response GetOrder429ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrder500JSONResponse Error
+
+func (response GetOrder500JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrder500ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder503JSONResponse Error
+
+func (response GetOrder503JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder503ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrder503ApplicationProblemPlusJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEventsRequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  GetOrderEventsParams
+}
+
+type GetOrderEventsResponseObject interface {
+	VisitGetOrderEventsResponse(w http.ResponseWriter) error
+}
+
+type GetOrderEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetOrderEvents200TexteventStreamResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetOrderEvents400JSONResponse Error
+
+func (response GetOrderEvents400JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderEvents400ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type GetOrderEvents401JSONResponse struct {
+	Body    Error
+	Headers GetOrderEvents401ResponseHeaders
+}
+
+func (response GetOrderEvents401JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderEvents401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrderEvents401ResponseHeaders
+}
+
+func (response GetOrderEvents401ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderEvents403JSONResponse Error
+
+func (response GetOrderEvents403JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderEvents403ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents404JSONResponse Error
+
+func (response GetOrderEvents404JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderEvents404ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type GetOrderEvents429JSONResponse struct {
+	Body    Error
+	Headers GetOrderEvents429ResponseHeaders
+}
+
+func (response GetOrderEvents429JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderEvents429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrderEvents429ResponseHeaders
+}
+
+func (response GetOrderEvents429ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderEvents500JSONResponse Error
+
+func (response GetOrderEvents500JSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEvents500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderEvents500ApplicationProblemPlusJSONResponse) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderRequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  CancelOrderParams
+	Body    *CancelOrderJSONRequestBody
+}
+
+type CancelOrderResponseObject interface {
+	VisitCancelOrderResponse(w http.ResponseWriter) error
+}
+
+type CancelOrder200ResponseHeaders struct {
+	ETag string
+}
+
+type CancelOrder200JSONResponse struct {
+	Body    Order
+	Headers CancelOrder200ResponseHeaders
+}
+
+func (response CancelOrder200JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrder400JSONResponse Error
+
+func (response CancelOrder400JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder400ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder400ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type CancelOrder401JSONResponse struct {
+	Body    Error
+	Headers CancelOrder401ResponseHeaders
+}
+
+func (response CancelOrder401JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrder401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CancelOrder401ResponseHeaders
+}
+
+func (response CancelOrder401ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrder403JSONResponse Error
+
+func (response CancelOrder403JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder403ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder403ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder404JSONResponse Error
+
+func (response CancelOrder404JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder404ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder404ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder409JSONResponse Error
+
+func (response CancelOrder409JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder409ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder409ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder412JSONResponse Error
+
+func (response CancelOrder412JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder412ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder412ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type CancelOrder429JSONResponse struct {
+	Body    Error
+	Headers CancelOrder429ResponseHeaders
+}
+
+func (response CancelOrder429JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrder429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CancelOrder429ResponseHeaders
+}
+
+func (response CancelOrder429ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrder500JSONResponse Error
+
+func (response CancelOrder500JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder500ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder500ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder503JSONResponse Error
+
+func (response CancelOrder503JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder503ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrder503ApplicationProblemPlusJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+	Params ListWebhooksParams
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse WebhookList
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks400JSONResponse Error
+
+func (response ListWebhooks400JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhooks400ApplicationProblemPlusJSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type ListWebhooks401JSONResponse struct {
+	Body    Error
+	Headers ListWebhooks401ResponseHeaders
+}
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhooks401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListWebhooks401ResponseHeaders
+}
+
+func (response ListWebhooks401ApplicationProblemPlusJSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhooks403JSONResponse Error
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhooks403ApplicationProblemPlusJSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type ListWebhooks429JSONResponse struct {
+	Body    Error
+	Headers ListWebhooks429ResponseHeaders
+}
+
+func (response ListWebhooks429JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhooks429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListWebhooks429ResponseHeaders
+}
+
+func (response ListWebhooks429ApplicationProblemPlusJSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhooks500JSONResponse Error
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhooks500ApplicationProblemPlusJSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Params CreateWebhookParams
+	Body   *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse CreatedWebhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse Error
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateWebhook400ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type CreateWebhook401JSONResponse struct {
+	Body    Error
+	Headers CreateWebhook401ResponseHeaders
+}
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CreateWebhook401ResponseHeaders
+}
+
+func (response CreateWebhook401ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook403JSONResponse Error
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateWebhook403ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type CreateWebhook429JSONResponse struct {
+	Body    Error
+	Headers CreateWebhook429ResponseHeaders
+}
+
+func (response CreateWebhook429JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CreateWebhook429ResponseHeaders
+}
+
+func (response CreateWebhook429ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook500JSONResponse Error
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateWebhook500ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Webhookid openapi_types.UUID `json:"webhookid"`
+	Params    DeleteWebhookParams
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook400JSONResponse Error
+
+func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteWebhook400ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type DeleteWebhook401JSONResponse struct {
+	Body    Error
+	Headers DeleteWebhook401ResponseHeaders
+}
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers DeleteWebhook401ResponseHeaders
+}
+
+func (response DeleteWebhook401ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook403JSONResponse Error
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteWebhook403ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse Error
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteWebhook404ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type DeleteWebhook429JSONResponse struct {
+	Body    Error
+	Headers DeleteWebhook429ResponseHeaders
+}
+
+func (response DeleteWebhook429JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers DeleteWebhook429ResponseHeaders
+}
+
+func (response DeleteWebhook429ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook500JSONResponse Error
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteWebhook500ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Webhookid openapi_types.UUID `json:"webhookid"`
+	Params    ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400JSONResponse Error
+
+func (response ListWebhookDeliveries400JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhookDeliveries400ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type ListWebhookDeliveries401JSONResponse struct {
+	Body    Error
+	Headers ListWebhookDeliveries401ResponseHeaders
+}
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeliveries401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListWebhookDeliveries401ResponseHeaders
+}
+
+func (response ListWebhookDeliveries401ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeliveries403JSONResponse Error
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhookDeliveries403ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse Error
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhookDeliveries404ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type ListWebhookDeliveries429JSONResponse struct {
+	Body    Error
+	Headers ListWebhookDeliveries429ResponseHeaders
+}
+
+func (response ListWebhookDeliveries429JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeliveries429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListWebhookDeliveries429ResponseHeaders
+}
+
+func (response ListWebhookDeliveries429ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeliveries500JSONResponse Error
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListWebhookDeliveries500ApplicationProblemPlusJSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveriesRequestObject struct {
+	Webhookid openapi_types.UUID `json:"webhookid"`
+	Params    ReplayWebhookDeliveriesParams
+}
+
+type ReplayWebhookDeliveriesResponseObject interface {
+	VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ReplayWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ReplayWebhookDeliveries200JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries400JSONResponse Error
+
+func (response ReplayWebhookDeliveries400JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries400ApplicationProblemPlusJSONResponse Problem
+
+func (response ReplayWebhookDeliveries400ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type ReplayWebhookDeliveries401JSONResponse struct {
+	Body    Error
+	Headers ReplayWebhookDeliveries401ResponseHeaders
+}
+
+func (response ReplayWebhookDeliveries401JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplayWebhookDeliveries401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ReplayWebhookDeliveries401ResponseHeaders
+}
+
+func (response ReplayWebhookDeliveries401ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplayWebhookDeliveries403JSONResponse Error
+
+func (response ReplayWebhookDeliveries403JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries403ApplicationProblemPlusJSONResponse Problem
+
+func (response ReplayWebhookDeliveries403ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries404JSONResponse Error
+
+func (response ReplayWebhookDeliveries404JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries404ApplicationProblemPlusJSONResponse Problem
+
+func (response ReplayWebhookDeliveries404ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type ReplayWebhookDeliveries429JSONResponse struct {
+	Body    Error
+	Headers ReplayWebhookDeliveries429ResponseHeaders
+}
+
+func (response ReplayWebhookDeliveries429JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplayWebhookDeliveries429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ReplayWebhookDeliveries429ResponseHeaders
+}
+
+func (response ReplayWebhookDeliveries429ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplayWebhookDeliveries500JSONResponse Error
+
+func (response ReplayWebhookDeliveries500JSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplayWebhookDeliveries500ApplicationProblemPlusJSONResponse Problem
+
+func (response ReplayWebhookDeliveries500ApplicationProblemPlusJSONResponse) VisitReplayWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2RequestObject struct {
+	Params ListOrdersV2Params
+}
+
+type ListOrdersV2ResponseObject interface {
+	VisitListOrdersV2Response(w http.ResponseWriter) error
+}
+
+type ListOrdersV2200ResponseHeaders struct {
+	ETag string
+}
+
+type ListOrdersV2200JSONResponse struct {
+	Body    OrderSummaryListV2
+	Headers ListOrdersV2200ResponseHeaders
+}
+
+func (response ListOrdersV2200JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersV2304ResponseHeaders struct {
+	ETag string
+}
+
+type ListOrdersV2304Response struct {
+	Headers ListOrdersV2304ResponseHeaders
+}
+
+func (response ListOrdersV2304Response) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type ListOrdersV2400JSONResponse Error
+
+func (response ListOrdersV2400JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2400ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrdersV2400ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type ListOrdersV2401JSONResponse struct {
+	Body    Error
+	Headers ListOrdersV2401ResponseHeaders
+}
+
+func (response ListOrdersV2401JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersV2401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListOrdersV2401ResponseHeaders
+}
+
+func (response ListOrdersV2401ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersV2403JSONResponse Error
+
+func (response ListOrdersV2403JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2403ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrdersV2403ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type ListOrdersV2429JSONResponse struct {
+	Body    Error
+	Headers ListOrdersV2429ResponseHeaders
+}
+
+func (response ListOrdersV2429JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersV2429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers ListOrdersV2429ResponseHeaders
+}
+
+func (response ListOrdersV2429ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersV2500JSONResponse Error
+
+func (response ListOrdersV2500JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2500ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrdersV2500ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2503JSONResponse Error
+
+func (response ListOrdersV2503JSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersV2503ApplicationProblemPlusJSONResponse Problem
+
+func (response ListOrdersV2503ApplicationProblemPlusJSONResponse) VisitListOrdersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2RequestObject struct {
+	Params PlaceOrderV2Params
+	Body   *PlaceOrderV2JSONRequestBody
+}
+
+type PlaceOrderV2ResponseObject interface {
+	VisitPlaceOrderV2Response(w http.ResponseWriter) error
+}
+
+type PlaceOrderV2200JSONResponse OrderSummaryV2
+
+func (response PlaceOrderV2200JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2400JSONResponse Error
+
+func (response PlaceOrderV2400JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2400ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2400ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type PlaceOrderV2401JSONResponse struct {
+	Body    Error
+	Headers PlaceOrderV2401ResponseHeaders
+}
+
+func (response PlaceOrderV2401JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrderV2401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers PlaceOrderV2401ResponseHeaders
+}
+
+func (response PlaceOrderV2401ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrderV2403JSONResponse Error
+
+func (response PlaceOrderV2403JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2403ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2403ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2409JSONResponse Error
+
+func (response PlaceOrderV2409JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2409ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2422JSONResponse Error
+
+func (response PlaceOrderV2422JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2422ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2422ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type PlaceOrderV2429JSONResponse struct {
+	Body    Error
+	Headers PlaceOrderV2429ResponseHeaders
+}
+
+func (response PlaceOrderV2429JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrderV2429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers PlaceOrderV2429ResponseHeaders
+}
+
+func (response PlaceOrderV2429ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlaceOrderV2500JSONResponse Error
+
+func (response PlaceOrderV2500JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2500ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2500ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2503JSONResponse Error
+
+func (response PlaceOrderV2503JSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceOrderV2503ApplicationProblemPlusJSONResponse Problem
+
+func (response PlaceOrderV2503ApplicationProblemPlusJSONResponse) VisitPlaceOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2RequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  GetOrderV2Params
+}
+
+type GetOrderV2ResponseObject interface {
+	VisitGetOrderV2Response(w http.ResponseWriter) error
+}
+
+type GetOrderV2200ResponseHeaders struct {
+	ETag string
+}
+
+type GetOrderV2200JSONResponse struct {
+	Body    OrderV2
+	Headers GetOrderV2200ResponseHeaders
+}
+
+func (response GetOrderV2200JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderV2304ResponseHeaders struct {
+	ETag string
+}
+
+type GetOrderV2304Response struct {
+	Headers GetOrderV2304ResponseHeaders
+}
+
+func (response GetOrderV2304Response) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetOrderV2400JSONResponse Error
+
+func (response GetOrderV2400JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderV2400ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type GetOrderV2401JSONResponse struct {
+	Body    Error
+	Headers GetOrderV2401ResponseHeaders
+}
+
+func (response GetOrderV2401JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderV2401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrderV2401ResponseHeaders
+}
+
+func (response GetOrderV2401ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderV2403JSONResponse Error
+
+func (response GetOrderV2403JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderV2403ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2404JSONResponse Error
+
+func (response GetOrderV2404JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderV2404ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type GetOrderV2429JSONResponse struct {
+	Body    Error
+	Headers GetOrderV2429ResponseHeaders
+}
+
+func (response GetOrderV2429JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderV2429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers GetOrderV2429ResponseHeaders
+}
+
+func (response GetOrderV2429ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderV2500JSONResponse Error
+
+func (response GetOrderV2500JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2500ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderV2500ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2503JSONResponse Error
+
+func (response GetOrderV2503JSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderV2503ApplicationProblemPlusJSONResponse Problem
+
+func (response GetOrderV2503ApplicationProblemPlusJSONResponse) VisitGetOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2RequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  CancelOrderV2Params
+	Body    *CancelOrderV2JSONRequestBody
+}
+
+type CancelOrderV2ResponseObject interface {
+	VisitCancelOrderV2Response(w http.ResponseWriter) error
+}
+
+type CancelOrderV2200ResponseHeaders struct {
+	ETag string
+}
+
+type CancelOrderV2200JSONResponse struct {
+	Body    OrderV2
+	Headers CancelOrderV2200ResponseHeaders
+}
+
+func (response CancelOrderV2200JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderV2400JSONResponse Error
+
+func (response CancelOrderV2400JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2400ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2400ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type CancelOrderV2401JSONResponse struct {
+	Body    Error
+	Headers CancelOrderV2401ResponseHeaders
+}
+
+func (response CancelOrderV2401JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderV2401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CancelOrderV2401ResponseHeaders
+}
+
+func (response CancelOrderV2401ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderV2403JSONResponse Error
+
+func (response CancelOrderV2403JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2403ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2403ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2404JSONResponse Error
+
+func (response CancelOrderV2404JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2404ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2404ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2409JSONResponse Error
+
+func (response CancelOrderV2409JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2409ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2412JSONResponse Error
+
+func (response CancelOrderV2412JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2412ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2412ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type CancelOrderV2429JSONResponse struct {
+	Body    Error
+	Headers CancelOrderV2429ResponseHeaders
+}
+
+func (response CancelOrderV2429JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderV2429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers CancelOrderV2429ResponseHeaders
+}
+
+func (response CancelOrderV2429ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderV2500JSONResponse Error
+
+func (response CancelOrderV2500JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2500ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2500ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2503JSONResponse Error
+
+func (response CancelOrderV2503JSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderV2503ApplicationProblemPlusJSONResponse Problem
+
+func (response CancelOrderV2503ApplicationProblemPlusJSONResponse) VisitCancelOrderV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrderRequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  HoldOrderParams
+	Body    *HoldOrderJSONRequestBody
+}
+
+type HoldOrderResponseObject interface {
+	VisitHoldOrderResponse(w http.ResponseWriter) error
+}
+
+type HoldOrder200ResponseHeaders struct {
+	ETag string
+}
+
+type HoldOrder200JSONResponse struct {
+	Body    OrderV2
+	Headers HoldOrder200ResponseHeaders
+}
+
+func (response HoldOrder200JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HoldOrder400JSONResponse Error
+
+func (response HoldOrder400JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder400ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder400ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type HoldOrder401JSONResponse struct {
+	Body    Error
+	Headers HoldOrder401ResponseHeaders
+}
+
+func (response HoldOrder401JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HoldOrder401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers HoldOrder401ResponseHeaders
+}
+
+func (response HoldOrder401ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HoldOrder403JSONResponse Error
+
+func (response HoldOrder403JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder403ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder403ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder404JSONResponse Error
+
+func (response HoldOrder404JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder404ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder404ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder409JSONResponse Error
+
+func (response HoldOrder409JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder409ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder409ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder412JSONResponse Error
+
+func (response HoldOrder412JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder412ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder412ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type HoldOrder429JSONResponse struct {
+	Body    Error
+	Headers HoldOrder429ResponseHeaders
+}
+
+func (response HoldOrder429JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HoldOrder429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers HoldOrder429ResponseHeaders
+}
+
+func (response HoldOrder429ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HoldOrder500JSONResponse Error
+
+func (response HoldOrder500JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder500ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder500ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder503JSONResponse Error
+
+func (response HoldOrder503JSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HoldOrder503ApplicationProblemPlusJSONResponse Problem
+
+func (response HoldOrder503ApplicationProblemPlusJSONResponse) VisitHoldOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatusRequestObject struct {
+	Orderid openapi_types.UUID `json:"orderid"`
+	Params  SetOrderStatusParams
+	Body    *SetOrderStatusJSONRequestBody
+}
+
+type SetOrderStatusResponseObject interface {
+	VisitSetOrderStatusResponse(w http.ResponseWriter) error
+}
+
+type SetOrderStatus200ResponseHeaders struct {
+	ETag string
+}
+
+type SetOrderStatus200JSONResponse struct {
+	Body    OrderV2
+	Headers SetOrderStatus200ResponseHeaders
+}
+
+func (response SetOrderStatus200JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetOrderStatus400JSONResponse Error
+
+func (response SetOrderStatus400JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus400ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus400ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus401ResponseHeaders struct {
+	WWWAuthenticate string
+}
+
+type SetOrderStatus401JSONResponse struct {
+	Body    Error
+	Headers SetOrderStatus401ResponseHeaders
+}
+
+func (response SetOrderStatus401JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetOrderStatus401ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers SetOrderStatus401ResponseHeaders
+}
+
+func (response SetOrderStatus401ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetOrderStatus403JSONResponse Error
+
+func (response SetOrderStatus403JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus403ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus403ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus404JSONResponse Error
+
+func (response SetOrderStatus404JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus404ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus404ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus409JSONResponse Error
+
+func (response SetOrderStatus409JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus409ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus409ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus412JSONResponse Error
+
+func (response SetOrderStatus412JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus412ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus412ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus429ResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+
+type SetOrderStatus429JSONResponse struct {
+	Body    Error
+	Headers SetOrderStatus429ResponseHeaders
+}
+
+func (response SetOrderStatus429JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetOrderStatus429ApplicationProblemPlusJSONResponse struct {
+	Body    Problem
+	Headers SetOrderStatus429ResponseHeaders
+}
+
+func (response SetOrderStatus429ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetOrderStatus500JSONResponse Error
+
+func (response SetOrderStatus500JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus500ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus500ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus503JSONResponse Error
+
+func (response SetOrderStatus503JSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOrderStatus503ApplicationProblemPlusJSONResponse Problem
+
+func (response SetOrderStatus503ApplicationProblemPlusJSONResponse) VisitSetOrderStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List a user's orders
+	// (GET /api/v1/orders)
+	ListOrders(ctx context.Context, request ListOrdersRequestObject) (ListOrdersResponseObject, error)
+	// Place a new order
+	// (POST /api/v1/orders)
+	PlaceOrder(ctx context.Context, request PlaceOrderRequestObject) (PlaceOrderResponseObject, error)
+	// Returns details of a specific order
+	// (GET /api/v1/orders/{orderid})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Stream changes to an order
+	// (GET /api/v1/orders/{orderid}/events)
+	GetOrderEvents(ctx context.Context, request GetOrderEventsRequestObject) (GetOrderEventsResponseObject, error)
+	// Cancel an order
+	// (POST /api/v1/orders/{orderid}:cancel)
+	CancelOrder(ctx context.Context, request CancelOrderRequestObject) (CancelOrderResponseObject, error)
+	// Returns the user's webhooks
+	// (GET /api/v1/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Register a webhook
+	// (POST /api/v1/webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook
+	// (DELETE /api/v1/webhooks/{webhookid})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Returns a webhook's delivery log
+	// (GET /api/v1/webhooks/{webhookid}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Replay a webhook's dead-lettered deliveries
+	// (POST /api/v1/webhooks/{webhookid}:replay)
+	ReplayWebhookDeliveries(ctx context.Context, request ReplayWebhookDeliveriesRequestObject) (ReplayWebhookDeliveriesResponseObject, error)
+	// List a user's orders (v2)
+	// (GET /api/v2/orders)
+	ListOrdersV2(ctx context.Context, request ListOrdersV2RequestObject) (ListOrdersV2ResponseObject, error)
+	// Place a new order
+	// (POST /api/v2/orders)
+	PlaceOrderV2(ctx context.Context, request PlaceOrderV2RequestObject) (PlaceOrderV2ResponseObject, error)
+	// Returns details of a specific order
+	// (GET /api/v2/orders/{orderid})
+	GetOrderV2(ctx context.Context, request GetOrderV2RequestObject) (GetOrderV2ResponseObject, error)
+	// Cancel an order
+	// (POST /api/v2/orders/{orderid}:cancel)
+	CancelOrderV2(ctx context.Context, request CancelOrderV2RequestObject) (CancelOrderV2ResponseObject, error)
+	// Put an order on hold
+	// (POST /api/v2/orders/{orderid}:hold)
+	HoldOrder(ctx context.Context, request HoldOrderRequestObject) (HoldOrderResponseObject, error)
+	// Change an order's status
+	// (POST /api/v2/orders/{orderid}:setStatus)
+	SetOrderStatus(ctx context.Context, request SetOrderStatusRequestObject) (SetOrderStatusResponseObject, error)
+}
+
+type StrictHandlerFunc = runtime.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = runtime.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListOrders operation middleware
+func (sh *strictHandler) ListOrders(w http.ResponseWriter, r *http.Request, params ListOrdersParams) {
+	var request ListOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrders(ctx, request.(ListOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrders")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrdersResponseObject); ok {
+		if err := validResponse.VisitListOrdersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PlaceOrder operation middleware
+func (sh *strictHandler) PlaceOrder(w http.ResponseWriter, r *http.Request, params PlaceOrderParams) {
+	var request PlaceOrderRequestObject
+
+	request.Params = params
+
+	var body PlaceOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PlaceOrder(ctx, request.(PlaceOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlaceOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PlaceOrderResponseObject); ok {
+		if err := validResponse.VisitPlaceOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderParams) {
+	var request GetOrderRequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx, request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		if err := validResponse.VisitGetOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetOrderEvents operation middleware
+func (sh *strictHandler) GetOrderEvents(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderEventsParams) {
+	var request GetOrderEventsRequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderEvents(ctx, request.(GetOrderEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrderEventsResponseObject); ok {
+		if err := validResponse.VisitGetOrderEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CancelOrder operation middleware
+func (sh *strictHandler) CancelOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params CancelOrderParams) {
+	var request CancelOrderRequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	var body CancelOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrder(ctx, request.(CancelOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelOrderResponseObject); ok {
+		if err := validResponse.VisitCancelOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams) {
+	var request ListWebhooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request, params CreateWebhookParams) {
+	var request CreateWebhookRequestObject
+
+	request.Params = params
+
+	var body CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params DeleteWebhookParams) {
+	var request DeleteWebhookRequestObject
+
+	request.Webhookid = webhookid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.Webhookid = webhookid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ReplayWebhookDeliveries operation middleware
+func (sh *strictHandler) ReplayWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookid openapi_types.UUID, params ReplayWebhookDeliveriesParams) {
+	var request ReplayWebhookDeliveriesRequestObject
+
+	request.Webhookid = webhookid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplayWebhookDeliveries(ctx, request.(ReplayWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplayWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplayWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitReplayWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// ListOrdersV2 operation middleware
+func (sh *strictHandler) ListOrdersV2(w http.ResponseWriter, r *http.Request, params ListOrdersV2Params) {
+	var request ListOrdersV2RequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrdersV2(ctx, request.(ListOrdersV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrdersV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrdersV2ResponseObject); ok {
+		if err := validResponse.VisitListOrdersV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// PlaceOrderV2 operation middleware
+func (sh *strictHandler) PlaceOrderV2(w http.ResponseWriter, r *http.Request, params PlaceOrderV2Params) {
+	var request PlaceOrderV2RequestObject
+
+	request.Params = params
+
+	var body PlaceOrderV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PlaceOrderV2(ctx, request.(PlaceOrderV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlaceOrderV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PlaceOrderV2ResponseObject); ok {
+		if err := validResponse.VisitPlaceOrderV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// GetOrderV2 operation middleware
+func (sh *strictHandler) GetOrderV2(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params GetOrderV2Params) {
+	var request GetOrderV2RequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderV2(ctx, request.(GetOrderV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrderV2ResponseObject); ok {
+		if err := validResponse.VisitGetOrderV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// CancelOrderV2 operation middleware
+func (sh *strictHandler) CancelOrderV2(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params CancelOrderV2Params) {
+	var request CancelOrderV2RequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	var body CancelOrderV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrderV2(ctx, request.(CancelOrderV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrderV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelOrderV2ResponseObject); ok {
+		if err := validResponse.VisitCancelOrderV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// HoldOrder operation middleware
+func (sh *strictHandler) HoldOrder(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params HoldOrderParams) {
+	var request HoldOrderRequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	var body HoldOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HoldOrder(ctx, request.(HoldOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HoldOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HoldOrderResponseObject); ok {
+		if err := validResponse.VisitHoldOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
+
+// SetOrderStatus operation middleware
+func (sh *strictHandler) SetOrderStatus(w http.ResponseWriter, r *http.Request, orderid openapi_types.UUID, params SetOrderStatusParams) {
+	var request SetOrderStatusRequestObject
+
+	request.Orderid = orderid
+	request.Params = params
+
+	var body SetOrderStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetOrderStatus(ctx, request.(SetOrderStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetOrderStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetOrderStatusResponseObject); ok {
+		if err := validResponse.VisitSetOrderStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("Unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,14 @@
+// Package api holds the server code generated from rest-api-spec.yaml. Run go generate after
+// changing the spec.
+package api
+
+import _ "embed"
+
+// The spec is copied into this package because go:embed cannot reach files outside of the module.
+//go:generate cp ../../../../../rest-api-spec.yaml openapi.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen@v1.13.4 -config server.cfg.yaml openapi.yaml
+
+// Spec is the YAML spec that the code in this package was generated from.
+//
+//go:embed openapi.yaml
+var Spec []byte
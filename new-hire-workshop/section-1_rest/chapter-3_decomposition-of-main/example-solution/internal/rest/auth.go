@@ -0,0 +1,243 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+const (
+	authorizationHeader   = "Authorization"
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	tenantIDHeader        = "X-Tenant-ID"
+	// tokenClockSkew is how far the server's clock may be from the identity provider's before
+	// tokens are rejected as expired or not valid yet.
+	tokenClockSkew = 30 * time.Second
+)
+
+// errNoBearerToken is the reason a request without a bearer token is rejected. It is told apart
+// from a token that is not valid, as RFC 6750 only has the challenge give an error for the latter.
+var errNoBearerToken = errors.New("request has no bearer token")
+
+// tenantIDPattern matches the tenant IDs that are accepted, which are kept short and to URL safe
+// characters as they end up in logs and database keys.
+var tenantIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// principalKey is the context key for the user that a request is from.
+type principalKey struct{}
+
+// tokenClaims are the claims read from a bearer token.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	// Roles are the names of the user's roles. Tokens without the claim are customers', so that
+	// identity providers that only issue tokens to customers do not need to add it. Roles that
+	// the API does not know about are ignored.
+	Roles []string `json:"roles"`
+	// TenantID is the tenant the user belongs to. It is required, as there is no safe tenant to
+	// assume for a user whose token does not say.
+	TenantID string `json:"tenant_id"`
+}
+
+// Authenticator works out which user each request is from, rejecting the request with a 401 if it
+// cannot.
+type Authenticator struct {
+	// parser and keys verify bearer tokens. They are nil if the user is taken from the userid query
+	// parameter instead.
+	parser *jwt.Parser
+	keys   *KeySet
+	logger logging.Logger
+}
+
+// NewTokenAuthenticator authenticates requests with an RS256 or ES256 JWT in their Authorization
+// header.
+func NewTokenAuthenticator(keys *KeySet, issuer, audience string, logger logging.Logger) *Authenticator {
+	return &Authenticator{
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+			jwt.WithIssuer(issuer),
+			jwt.WithAudience(audience),
+			jwt.WithExpirationRequired(),
+			jwt.WithLeeway(tokenClockSkew),
+		),
+		keys:   keys,
+		logger: logger,
+	}
+}
+
+// NewQueryParamAuthenticator takes the customer from the userid query parameter. It is only for
+// development.
+func NewQueryParamAuthenticator(logger logging.Logger) *Authenticator {
+	return &Authenticator{
+		logger: logger,
+	}
+}
+
+// Middleware adds the user that each request is from to the request's context.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var principal authz.Principal
+		var err error
+		if a.parser == nil {
+			principal, err = principalFromQuery(r)
+		} else {
+			principal, err = a.principalFromToken(r)
+		}
+
+		if err != nil {
+			if a.parser != nil {
+				w.Header().Set(wwwAuthenticateHeader, bearerChallenge(err))
+			}
+			writeErrorResponse(w, r, err, a.logger)
+			return
+		}
+
+		if a.parser != nil {
+			if err := checkTenantHeader(r, principal); err != nil {
+				writeErrorResponse(w, r, err, a.logger)
+				return
+			}
+		}
+
+		// Everything logged for the rest of the request says which user it is from, under the
+		// customer ID key if they are a customer.
+		fields := logging.Fields{"user-tenant-id": principal.TenantID}
+		if principal.HasRole(authz.RoleCustomer) {
+			fields[logging.CustomerIDKey] = principal.ID
+		} else {
+			fields[logging.UserIDKey] = principal.ID
+		}
+		ctx := context.WithValue(r.Context(), principalKey{}, principal)
+		ctx = logctx.WithFields(ctx, a.logger, fields)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// principalFromToken verifies the request's bearer token, returning the user it was issued to.
+func (a *Authenticator) principalFromToken(r *http.Request) (authz.Principal, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return authz.Principal{}, err
+	}
+
+	claims := &tokenClaims{}
+	_, err = a.parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return a.keys.key(r.Context(), keyID, token.Method.Alg())
+	})
+	if err != nil {
+		logctx.Logger(r.Context(), a.logger).WithError(err).Debug("rejected bearer token")
+
+		msg := "the bearer token is not valid"
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			msg = "the bearer token has expired"
+		}
+		return authz.Principal{}, newInvalidToken(msg, err)
+	}
+
+	principal := authz.Principal{
+		ID:       claims.Subject,
+		TenantID: claims.TenantID,
+		Roles:    []authz.Role{authz.RoleCustomer},
+	}
+	if claims.Roles != nil {
+		principal.Roles = nil
+		for _, name := range claims.Roles {
+			if role, ok := authz.ParseRole(name); ok {
+				principal.Roles = append(principal.Roles, role)
+			}
+		}
+	}
+
+	if principal.ID == "" {
+		return authz.Principal{}, newInvalidToken("the bearer token has no subject", nil)
+	}
+
+	if !tenantIDPattern.MatchString(principal.TenantID) {
+		return authz.Principal{}, newInvalidToken("the bearer token has no valid tenant_id", nil)
+	}
+
+	// A customer's orders are stored under their ID, so it has to be one, while staff can be
+	// identified however the identity provider likes.
+	if _, err := uuid.Parse(principal.ID); err != nil && principal.HasRole(authz.RoleCustomer) {
+		return authz.Principal{}, newInvalidToken("the bearer token's subject is not a customer ID", err)
+	}
+
+	return principal, nil
+}
+
+// bearerToken returns the token from the request's Authorization header.
+func bearerToken(r *http.Request) (string, error) {
+	scheme, token, _ := strings.Cut(r.Header.Get(authorizationHeader), " ")
+	if !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", terrors.Presentable(terrors.NewUnauthenticated("a bearer token is required", errNoBearerToken))
+	}
+
+	return strings.TrimSpace(token), nil
+}
+
+// bearerChallenge returns the WWW-Authenticate challenge for a request that was rejected because of
+// its bearer token.
+func bearerChallenge(err error) string {
+	if errors.Is(err, errNoBearerToken) {
+		return "Bearer"
+	}
+
+	return `Bearer error="invalid_token"`
+}
+
+func newInvalidToken(msg string, err error) error {
+	return terrors.Presentable(terrors.NewUnauthenticated(msg, err))
+}
+
+func principalFromQuery(r *http.Request) (authz.Principal, error) {
+	customerID, err := uuid.Parse(r.URL.Query().Get("userid"))
+	if err != nil {
+		return authz.Principal{}, terrors.Presentable(terrors.NewUnauthenticated("the userid query parameter must be the ID of the user", err))
+	}
+
+	tenantID := r.Header.Get(tenantIDHeader)
+	if tenantID == "" {
+		tenantID = orders.DefaultTenantID
+	} else if !tenantIDPattern.MatchString(tenantID) {
+		return authz.Principal{}, terrors.Presentable(terrors.NewInvalidSingleInput(tenantIDHeader, "must be a tenant ID", nil))
+	}
+
+	return authz.Principal{
+		ID:       customerID.String(),
+		TenantID: tenantID,
+		Roles:    []authz.Role{authz.RoleCustomer},
+	}, nil
+}
+
+// checkTenantHeader rejects a request whose X-Tenant-ID header does not name the token's tenant.
+func checkTenantHeader(r *http.Request, principal authz.Principal) error {
+	tenantID := r.Header.Get(tenantIDHeader)
+	if tenantID == "" || tenantID == principal.TenantID {
+		return nil
+	}
+
+	msg := fmt.Sprintf("the %s header does not match the tenant of the bearer token", tenantIDHeader)
+	return terrors.Presentable(terrors.NewForbidden(msg, nil))
+}
+
+// principalFromContext returns the user that the Authenticator found a request to be from.
+func principalFromContext(ctx context.Context) (authz.Principal, error) {
+	principal, ok := ctx.Value(principalKey{}).(authz.Principal)
+	if !ok {
+		return authz.Principal{}, errors.New("request has not been authenticated")
+	}
+
+	return principal, nil
+}
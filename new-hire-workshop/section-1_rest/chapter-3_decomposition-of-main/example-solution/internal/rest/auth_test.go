@@ -0,0 +1,210 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "orders-api"
+	testKeyID    = "test"
+)
+
+func TestTokenAuthenticatorRejectsInvalidTokens(t *testing.T) {
+	server, issuer := newTokenTestServer(t)
+	customerID := uuid.NewString()
+
+	tests := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{
+			name:   "no token",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "expired",
+			header: issuer.header(issuer.claims("tenant", customerID, withClaim("exp", time.Now().Add(-time.Hour).Unix()))),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong issuer",
+			header: issuer.header(issuer.claims("tenant", customerID, withClaim("iss", "https://other.example.com"))),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong audience",
+			header: issuer.header(issuer.claims("tenant", customerID, withClaim("aud", "other-api"))),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "unsigned",
+			header: bearer(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, issuer.claims("tenant", customerID)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "HS256",
+			header: bearer(t, jwt.SigningMethodHS256, []byte("secret"), issuer.claims("tenant", customerID)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown key",
+			header: issuer.headerWithKeyID(issuer.claims("tenant", customerID), "unknown"),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "no tenant",
+			header: issuer.header(issuer.claims("", customerID)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "customer ID that is not a UUID",
+			header: issuer.header(issuer.claims("tenant", "customer")),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "tenant header for another tenant",
+			header: withHeader(issuer.header(issuer.claims("tenant", customerID)), "X-Tenant-ID", "other-tenant"),
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders", header: tt.header}, tt.status, nil)
+			if got := resp.Header.Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("rejection has content type %q, want a problem", got)
+			}
+		})
+	}
+
+	// The token is accepted once nothing is wrong with it, including a tenant header that names
+	// its tenant.
+	header := withHeader(issuer.header(issuer.claims("tenant", customerID)), "X-Tenant-ID", "tenant")
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders", header: header}, http.StatusOK, nil)
+}
+
+// tokenIssuer issues bearer tokens signed with the key in the key set of a token test server.
+type tokenIssuer struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+}
+
+// newTokenTestServer starts a server that authenticates requests with bearer tokens, along with the
+// issuer of its tokens.
+func newTokenTestServer(t *testing.T) (*testServer, *tokenIssuer) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating signing key: %v", err)
+	}
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"crv": "P-256",
+			"kid": testKeyID,
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("encoding JWKS: %v", err)
+	}
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksPath, jwks, 0o600); err != nil {
+		t.Fatalf("writing JWKS: %v", err)
+	}
+
+	logger := logging.NewNoopLogger()
+	keys, err := rest.LoadKeySet(context.Background(), jwksPath, logger)
+	if err != nil {
+		t.Fatalf("loading JWKS: %v", err)
+	}
+
+	server := newTestServerWithAuthenticator(t, rest.NewTokenAuthenticator(keys, testIssuer, testAudience, logger))
+	return server, &tokenIssuer{t: t, key: key}
+}
+
+// claims returns the claims of a valid token for a user in the tenant, changed by the options. The
+// tenant_id claim is left out if tenantID is empty.
+func (i *tokenIssuer) claims(tenantID, subject string, opts ...func(jwt.MapClaims)) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss": testIssuer,
+		"aud": testAudience,
+		"sub": subject,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if tenantID != "" {
+		claims["tenant_id"] = tenantID
+	}
+
+	for _, opt := range opts {
+		opt(claims)
+	}
+
+	return claims
+}
+
+// header returns an Authorization header with the claims signed by the key set's key.
+func (i *tokenIssuer) header(claims jwt.MapClaims) http.Header {
+	return i.headerWithKeyID(claims, testKeyID)
+}
+
+func (i *tokenIssuer) headerWithKeyID(claims jwt.MapClaims, keyID string) http.Header {
+	i.t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		i.t.Fatalf("signing token: %v", err)
+	}
+
+	return http.Header{"Authorization": {"Bearer " + signed}}
+}
+
+func withClaim(name string, value interface{}) func(jwt.MapClaims) {
+	return func(claims jwt.MapClaims) {
+		claims[name] = value
+	}
+}
+
+func withHeader(header http.Header, name, value string) http.Header {
+	header.Set(name, value)
+	return header
+}
+
+// bearer returns an Authorization header with the claims signed by a key outside the key set.
+func bearer(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) http.Header {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = testKeyID
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+
+	return http.Header{"Authorization": {"Bearer " + signed}}
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// ViolationReporter is told about each response that does not match the OpenAPI spec.
+type ViolationReporter func(r *http.Request, err error)
+
+// ResponseValidator reports the responses that do not match the OpenAPI spec. Event streams are
+// not checked.
+type ResponseValidator struct {
+	router routers.Router
+	report ViolationReporter
+}
+
+func NewResponseValidator(spec *openapi3.T, report ViolationReporter) (*ResponseValidator, error) {
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("building router from API spec: %w", err)
+	}
+
+	return &ResponseValidator{
+		router: router,
+		report: report,
+	}, nil
+}
+
+// Middleware checks the response to every request for an operation in the spec.
+func (v *ResponseValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			v.report(r, fmt.Errorf("request does not match an operation in the API spec: %w", err))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &recordingResponseWriter{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+
+		if isEventStream(recorder.Header()) {
+			return
+		}
+
+		input := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+			},
+			Status: recorder.statusCode(),
+			Header: recorder.Header(),
+			Options: &openapi3filter.Options{
+				IncludeResponseStatus: true,
+				MultiError:            true,
+			},
+		}
+		input.SetBodyBytes(recorder.body.Bytes())
+
+		if err := openapi3filter.ValidateResponse(r.Context(), input); err != nil {
+			v.report(r, err)
+		}
+	})
+}
+
+// recordingResponseWriter passes a response through to the client while keeping a copy of it,
+// unless it is an event stream, which could grow without end.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recordingResponseWriter) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recordingResponseWriter) Write(b []byte) (int, error) {
+	if !isEventStream(r.Header()) {
+		r.body.Write(b)
+	}
+
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush passes flushes through, so that event streams still reach the client as they are written.
+func (r *recordingResponseWriter) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *recordingResponseWriter) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+
+	return r.status
+}
+
+func isEventStream(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && mediaType == eventStreamContentType
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+//go:embed explorer.html
+var explorerPage []byte
+
+// Docs serves the API spec to consumers as YAML and JSON, along with a page for exploring the API
+// that needs nothing but the server, so it also works without internet access.
+type Docs struct {
+	specYAML []byte
+	specJSON []byte
+	logger   logging.Logger
+}
+
+// NewDocs serves specYAML as it is, so that its comments and ordering are kept, while the JSON is
+// generated from the parsed spec.
+func NewDocs(specYAML []byte, spec *openapi3.T, logger logging.Logger) (*Docs, error) {
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("converting API spec to JSON: %w", err)
+	}
+
+	return &Docs{
+		specYAML: specYAML,
+		specJSON: specJSON,
+		logger:   logger,
+	}, nil
+}
+
+func (d *Docs) register(router *mux.Router) {
+	router.HandleFunc("/api/v1/openapi.yaml", d.serve(d.specYAML, "application/yaml")).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/openapi.json", d.serve(d.specJSON, "application/json")).Methods(http.MethodGet)
+	router.HandleFunc("/api/docs", d.serve(explorerPage, "text/html; charset=utf-8")).Methods(http.MethodGet)
+}
+
+func (d *Docs) serve(body []byte, contentType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		if _, err := w.Write(body); err != nil {
+			logctx.Logger(r.Context(), d.logger).WithError(err).Error("failed to write docs")
+		}
+	}
+}
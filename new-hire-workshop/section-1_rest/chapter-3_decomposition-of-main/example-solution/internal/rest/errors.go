@@ -0,0 +1,201 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+const (
+	internalErrorMessage = "An internal error occurred"
+	problemContentType   = "application/problem+json"
+)
+
+// statusError is the error for a request that fails for a reason terrors has no type for, and so
+// carries the HTTP status code to respond with itself.
+type statusError struct {
+	status int
+	msg    string
+	err    error
+}
+
+// newUnprocessableRequest returns the error for a request that conflicts with an earlier one.
+func newUnprocessableRequest(msg string, err error) *statusError {
+	return &statusError{
+		status: http.StatusUnprocessableEntity,
+		msg:    msg,
+		err:    err,
+	}
+}
+
+// newPreconditionFailed returns the error for a request whose If-Match header does not match the
+// current version of what it would change.
+func newPreconditionFailed(msg string, err error) *statusError {
+	return &statusError{
+		status: http.StatusPreconditionFailed,
+		msg:    msg,
+		err:    err,
+	}
+}
+
+// newTooManyRequests returns the error for a request from a client that has used up its rate
+// limit.
+func newTooManyRequests(msg string) *statusError {
+	return &statusError{
+		status: http.StatusTooManyRequests,
+		msg:    msg,
+	}
+}
+
+func (e *statusError) Error() string {
+	return e.msg
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// errorStatus returns the HTTP status code for an error based on its terrors type, or the status
+// of a statusError. Errors of any other type are treated as internal errors.
+func errorStatus(err error) int {
+	statusErr := &statusError{}
+	switch {
+	case errors.As(err, &statusErr):
+		return statusErr.status
+	case errors.As(err, &terrors.InvalidInputType), errors.As(err, &terrors.InvalidParameterType):
+		return http.StatusBadRequest
+	case errors.As(err, &terrors.UnauthenticatedType):
+		return http.StatusUnauthorized
+	case errors.As(err, &terrors.ForbiddenType):
+		return http.StatusForbidden
+	case errors.As(err, &terrors.NotFoundType):
+		return http.StatusNotFound
+	case errors.As(err, &terrors.StateConflictType):
+		return http.StatusConflict
+	case errors.As(err, &terrors.UnsatisfiableRangeType):
+		return http.StatusRequestedRangeNotSatisfiable
+	case errors.As(err, &terrors.NotImplementedType):
+		return http.StatusNotImplemented
+	case errors.As(err, &terrors.ServiceUnavailableType):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeErrorResponse writes the error response for an error returned while handling a request.
+// Only the message of a terrors.Presentable error is sent to the client.
+func writeErrorResponse(w http.ResponseWriter, r *http.Request, err error, logger logging.Logger) {
+	logger = logctx.Logger(r.Context(), logger)
+	status := errorStatus(err)
+
+	unavailableErr := &terrors.ServiceUnavailable{}
+	if errors.As(err, &unavailableErr) && unavailableErr.RetryInterval > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(unavailableErr.RetryInterval))
+	}
+
+	msg := internalErrorMessage
+	var invalidParams *[]api.InvalidParam
+	if terrors.IsPresentable(err) {
+		msg = err.Error()
+		invalidParams = invalidParamsFromError(err)
+	} else {
+		logger.WithError(err).WithField("status", status).Error("request failed")
+	}
+
+	if !acceptsProblem(r) && requestVersion(r) == APIVersion1 {
+		writeErrorBody(w, api.Error{Message: msg}, "application/json", status, logger)
+		return
+	}
+
+	problem := api.Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(status),
+		Status:        status,
+		Detail:        &msg,
+		Instance:      &r.URL.Path,
+		InvalidParams: invalidParams,
+	}
+	writeErrorBody(w, problem, problemContentType, status, logger)
+}
+
+func writeErrorBody(w http.ResponseWriter, body interface{}, contentType string, status int, logger logging.Logger) {
+	errResp, err := json.Marshal(body)
+	if err != nil {
+		logger.WithError(err).WithField("rest-err", body).Error("failed to marshall error response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if _, err := w.Write(errResp); err != nil {
+		logger.WithError(err).WithField("err-resp", errResp).Error("failed to write error response")
+	}
+}
+
+// acceptsProblem reports whether the request's Accept header lists application/problem+json.
+func acceptsProblem(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(accepted)
+		if err != nil || params["q"] == "0" {
+			continue
+		}
+
+		if mediaType == problemContentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// invalidParamsFromError lists the inputs rejected by a terrors.InvalidInput error, or returns nil
+// if the error is of any other type.
+func invalidParamsFromError(err error) *[]api.InvalidParam {
+	invalidErr := &terrors.InvalidInput{}
+	if !errors.As(err, &invalidErr) {
+		return nil
+	}
+
+	invalidParams := make([]api.InvalidParam, 0, len(invalidErr.Inputs))
+	for _, input := range invalidErr.Inputs {
+		invalidParams = append(invalidParams, api.InvalidParam{
+			Name:   input.Input,
+			Reason: input.Msg,
+		})
+	}
+
+	return &invalidParams
+}
+
+// newInvalidParam returns the error for a request parameter that the generated code could not
+// bind to the operation's parameters.
+func newInvalidParam(err error) error {
+	requiredErr := &api.RequiredParamError{}
+	formatErr := &api.InvalidParamFormatError{}
+	tooManyErr := &api.TooManyValuesForParamError{}
+	switch {
+	case errors.As(err, &requiredErr):
+		return terrors.Presentable(terrors.NewInvalidSingleInput(requiredErr.ParamName, "is required", err))
+	case errors.As(err, &formatErr):
+		return terrors.Presentable(terrors.NewInvalidSingleInput(formatErr.ParamName, "is not valid", err))
+	case errors.As(err, &tooManyErr):
+		return terrors.Presentable(terrors.NewInvalidSingleInput(tooManyErr.ParamName, "must only be given once", err))
+	default:
+		return terrors.Presentable(terrors.NewInvalidSingleInput("request", "is not valid", err))
+	}
+}
+
+// newInvalidBody returns the error for a request body that could not be decoded.
+func newInvalidBody(err error) error {
+	return terrors.Presentable(terrors.NewInvalidSingleInput("request body", "is not valid JSON", err))
+}
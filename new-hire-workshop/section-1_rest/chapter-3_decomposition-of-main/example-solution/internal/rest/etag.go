@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// orderETag returns the ETag of an order, which is its version. The version changes whenever the
+// order does, so the ETag is a strong one.
+func orderETag(order orders.Order) string {
+	return fmt.Sprintf(`"%d"`, order.Version)
+}
+
+// listETag returns the ETag of a page of orders. It is a hash of the ID and version of each order
+// on the page, along with the token for the next page, as together they decide the whole response.
+func listETag(page orders.OrderPage, nextPageToken string) string {
+	hash := sha256.New()
+	for _, order := range page.Orders {
+		fmt.Fprintf(hash, "%s:%d\n", order.OrderID, order.Version)
+	}
+	hash.Write([]byte(nextPageToken))
+
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// noneMatch reports whether an If-None-Match header allows the response with the ETag to be sent.
+func noneMatch(header *string, etag string) bool {
+	if header == nil {
+		return true
+	}
+
+	for _, candidate := range splitETags(*header) {
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ifMatch reports whether an If-Match header allows a change to something with the ETag.
+func ifMatch(header *string, etag string) bool {
+	if header == nil {
+		return true
+	}
+
+	for _, candidate := range splitETags(*header) {
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitETags splits the comma separated list of ETags in a conditional request header.
+func splitETags(header string) []string {
+	var etags []string
+	for _, etag := range strings.Split(header, ",") {
+		if etag = strings.TrimSpace(etag); etag != "" {
+			etags = append(etags, etag)
+		}
+	}
+
+	return etags
+}
@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+
+	// heartbeatInterval is how often a comment is sent on an event stream that has nothing else to
+	// send, so that proxies do not close the connection for being idle.
+	heartbeatInterval = 15 * time.Second
+)
+
+// orderEventStream streams an order's events until the client disconnects or the server stops.
+// The ID of each delivery event is its entry's position in the order, counting from 1.
+type orderEventStream struct {
+	ctx    context.Context
+	sub    *orders.Subscription
+	order  orders.Order
+	logger logging.Logger
+
+	// The delivery entries and status the client has been sent.
+	sentEntries int
+	sentStatus  *api.OrderStatusEvent
+}
+
+func newOrderEventStream(ctx context.Context, sub *orders.Subscription, order orders.Order, lastEventID int, logger logging.Logger) *orderEventStream {
+	// An ID past the last entry cannot have come from this order, so the client is treated as
+	// having every entry rather than having the IDs of later entries skip ahead.
+	if lastEventID > len(order.DeliveryEntries) {
+		lastEventID = len(order.DeliveryEntries)
+	}
+
+	return &orderEventStream{
+		ctx:         ctx,
+		sub:         sub,
+		order:       order,
+		logger:      logger.WithField("order-id", order.OrderID),
+		sentEntries: lastEventID,
+	}
+}
+
+func (s *orderEventStream) VisitGetOrderEventsResponse(w http.ResponseWriter) error {
+	defer s.sub.Close()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("response writer does not support flushing, so cannot stream events")
+	}
+
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	// Once the status has been written, an error can no longer be sent to the client, so the
+	// stream just ends. Most errors from here on are from writing to a client that has gone.
+	if err := s.writeOrder(w, s.order); err != nil {
+		return nil
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-serverStopping(s.ctx):
+			return nil
+		case <-s.sub.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case order := <-s.sub.Updates():
+			// Concurrent updates can be published out of order, and an older version of the order
+			// has nothing the client has not already been sent.
+			if order.Version <= s.order.Version {
+				continue
+			}
+
+			s.order = order
+			if err := s.writeOrder(w, order); err != nil {
+				return nil
+			}
+		}
+
+		flusher.Flush()
+	}
+}
+
+// writeOrder writes an event for each of the order's delivery entries that the client has not been
+// sent yet, followed by one for the order's status if that has changed.
+func (s *orderEventStream) writeOrder(w io.Writer, order orders.Order) error {
+	entries := internalDeliveryEntriesToREST(order.DeliveryEntries)
+	for s.sentEntries < len(entries) {
+		entry := entries[s.sentEntries]
+		if err := writeEvent(w, strconv.Itoa(s.sentEntries+1), "delivery", entry); err != nil {
+			return err
+		}
+
+		s.sentEntries++
+	}
+
+	status, err := orderStatusToRESTV2(order.Status)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to convert order status for event stream")
+		return err
+	}
+
+	statusEvent := api.OrderStatusEvent{
+		Status: status,
+		Reason: optionalString(order.StatusReason),
+	}
+	if s.sentStatus != nil && statusEventsEqual(*s.sentStatus, statusEvent) {
+		return nil
+	}
+
+	if err := writeEvent(w, "", "status", statusEvent); err != nil {
+		return err
+	}
+
+	s.sentStatus = &statusEvent
+	return nil
+}
+
+// writeEvent writes a Server-Sent Event with the data encoded as JSON. An event without an ID
+// leaves the client's last event ID as it was.
+func writeEvent(w io.Writer, id, event string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, encoded)
+	return err
+}
+
+func statusEventsEqual(a, b api.OrderStatusEvent) bool {
+	return a.Status == b.Status && stringOrEmpty(a.Reason) == stringOrEmpty(b.Reason)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
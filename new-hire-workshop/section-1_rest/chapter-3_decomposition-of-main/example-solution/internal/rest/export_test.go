@@ -0,0 +1,8 @@
+package rest
+
+import "net"
+
+// Serve serves requests from the listener, for tests that cannot listen on a fixed port.
+func (s *Server) Serve(l net.Listener) error {
+	return s.server.Serve(l)
+}
@@ -1,72 +1,448 @@
 package rest
 
 import (
-	"ecommerce-workshop/internal/orders"
-	"encoding/json"
-	"net/http"
+	"context"
+	"errors"
+	"fmt"
 
-	"github.com/gorilla/mux"
+	"github.com/google/uuid"
 	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
 )
 
-type ListOrderSummariesHandler struct {
-	orderStore *orders.OrderStore
-	logger     logging.Logger
+// Handlers implements the operations of every version of the API, as generated from the API spec.
+type Handlers struct {
+	orderStore     orders.OrderRepository
+	orderService   *orders.Service
+	feed           *orders.Feed
+	webhookService *webhooks.Service
+	policy         *authz.Policy
+	logger         logging.Logger
 }
 
-func NewListOrderSummariesHandler(orderStore *orders.OrderStore, logger logging.Logger) *ListOrderSummariesHandler {
-	return &ListOrderSummariesHandler{
-		orderStore: orderStore,
-		logger:     logger,
+var _ api.StrictServerInterface = (*Handlers)(nil)
+
+// NewHandlers serves the event streams from the feed, so for them to see every change, the
+// orderStore must be the one that publishes to it.
+func NewHandlers(
+	orderStore orders.OrderRepository,
+	orderService *orders.Service,
+	feed *orders.Feed,
+	webhookService *webhooks.Service,
+	policy *authz.Policy,
+	logger logging.Logger,
+) *Handlers {
+	return &Handlers{
+		orderStore:     orderStore,
+		orderService:   orderService,
+		feed:           feed,
+		webhookService: webhookService,
+		policy:         policy,
+		logger:         logger,
+	}
+}
+
+func (h *Handlers) ListOrders(ctx context.Context, request api.ListOrdersRequestObject) (api.ListOrdersResponseObject, error) {
+	params := request.Params
+	page, nextPageToken, err := h.listOrders(ctx, params.CustomerId, listParams{
+		Limit:         params.Limit,
+		PageToken:     params.PageToken,
+		Statuses:      stringValues(params.Status),
+		OrderedFrom:   params.OrderedFrom,
+		OrderedBefore: params.OrderedBefore,
+		Sort:          stringValue(params.Sort),
+	}, APIVersion1)
+	if err != nil {
+		return nil, err
+	}
+
+	etag := listETag(page, nextPageToken)
+	if !noneMatch(params.IfNoneMatch, etag) {
+		return api.ListOrders304Response{Headers: api.ListOrders304ResponseHeaders{ETag: etag}}, nil
+	}
+
+	summaries, err := internalOrdersToSummaries(page.Orders, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ListOrders200JSONResponse{
+		Body:    summaries,
+		Headers: api.ListOrders200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *Handlers) ListOrdersV2(ctx context.Context, request api.ListOrdersV2RequestObject) (api.ListOrdersV2ResponseObject, error) {
+	params := request.Params
+	page, nextPageToken, err := h.listOrders(ctx, params.CustomerId, listParams{
+		Limit:         params.Limit,
+		PageToken:     params.PageToken,
+		Statuses:      stringValues(params.Status),
+		OrderedFrom:   params.OrderedFrom,
+		OrderedBefore: params.OrderedBefore,
+		Sort:          stringValue(params.Sort),
+	}, APIVersion2)
+	if err != nil {
+		return nil, err
+	}
+
+	etag := listETag(page, nextPageToken)
+	if !noneMatch(params.IfNoneMatch, etag) {
+		return api.ListOrdersV2304Response{Headers: api.ListOrdersV2304ResponseHeaders{ETag: etag}}, nil
+	}
+
+	summaries, err := internalOrdersToSummariesV2(page.Orders, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ListOrdersV2200JSONResponse{
+		Body:    summaries,
+		Headers: api.ListOrdersV2200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+// listOrders lists the orders of the customer given in the customerId parameter, or of the user
+// that the request is from if it is not given. Either way only the user's tenant is searched.
+func (h *Handlers) listOrders(ctx context.Context, customerIDParam *uuid.UUID, params listParams, version APIVersion) (orders.OrderPage, string, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return orders.OrderPage{}, "", err
+	}
+
+	customerID := principal.ID
+	if customerIDParam != nil {
+		customerID = customerIDParam.String()
+	}
+
+	if err := h.policy.Authorize(ctx, principal, authz.ActionReadOrders, authz.Resource{TenantID: principal.TenantID, CustomerID: customerID}); err != nil {
+		return orders.OrderPage{}, "", err
+	}
+
+	opts, err := parseListOptions(params, version)
+	if err != nil {
+		return orders.OrderPage{}, "", err
+	}
+
+	page, err := h.orderStore.ListOrders(ctx, principal.TenantID, customerID, opts)
+	if err != nil {
+		return orders.OrderPage{}, "", err
+	}
+
+	nextPageToken, err := encodePageToken(page.Next)
+	if err != nil {
+		return orders.OrderPage{}, "", err
+	}
+
+	return page, nextPageToken, nil
+}
+
+func (h *Handlers) PlaceOrder(ctx context.Context, request api.PlaceOrderRequestObject) (api.PlaceOrderResponseObject, error) {
+	order, err := h.placeOrder(ctx, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := internalOrderToSummary(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PlaceOrder200JSONResponse(summary), nil
+}
+
+func (h *Handlers) PlaceOrderV2(ctx context.Context, request api.PlaceOrderV2RequestObject) (api.PlaceOrderV2ResponseObject, error) {
+	order, err := h.placeOrder(ctx, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := internalOrderToSummaryV2(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PlaceOrderV2200JSONResponse(summary), nil
+}
+
+// placeOrder places an order for the user that the request is from. Staff cannot place orders on
+// behalf of customers.
+func (h *Handlers) placeOrder(ctx context.Context, req api.PlaceOrderRequest) (orders.Order, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	if err := h.policy.Authorize(ctx, principal, authz.ActionPlaceOrder, authz.Resource{TenantID: principal.TenantID, CustomerID: principal.ID}); err != nil {
+		return orders.Order{}, err
+	}
+
+	if err := validatePlaceOrderRequest(req); err != nil {
+		return orders.Order{}, err
+	}
+
+	return h.orderService.PlaceOrder(ctx, principal.TenantID, principal.ID, req.ProductId.String(), req.Address, req.PaymentId.String())
+}
+
+func (h *Handlers) GetOrder(ctx context.Context, request api.GetOrderRequestObject) (api.GetOrderResponseObject, error) {
+	order, err := h.getOrder(ctx, request.Orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	etag := orderETag(order)
+	if !noneMatch(request.Params.IfNoneMatch, etag) {
+		return api.GetOrder304Response{Headers: api.GetOrder304ResponseHeaders{ETag: etag}}, nil
+	}
+
+	restOrder, err := internalOrderToREST(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetOrder200JSONResponse{
+		Body:    restOrder,
+		Headers: api.GetOrder200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *Handlers) GetOrderV2(ctx context.Context, request api.GetOrderV2RequestObject) (api.GetOrderV2ResponseObject, error) {
+	order, err := h.getOrder(ctx, request.Orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	etag := orderETag(order)
+	if !noneMatch(request.Params.IfNoneMatch, etag) {
+		return api.GetOrderV2304Response{Headers: api.GetOrderV2304ResponseHeaders{ETag: etag}}, nil
+	}
+
+	restOrder, err := internalOrderToRESTV2(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetOrderV2200JSONResponse{
+		Body:    restOrder,
+		Headers: api.GetOrderV2200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+// getOrder returns an order that the user the request is from may read.
+func (h *Handlers) getOrder(ctx context.Context, orderID uuid.UUID) (orders.Order, error) {
+	return h.authorizedOrder(ctx, orderID, authz.ActionReadOrders)
+}
+
+// authorizedOrder returns an order that the user may take the action on. Orders the user may not
+// see are not found.
+func (h *Handlers) authorizedOrder(ctx context.Context, orderID uuid.UUID, action authz.Action) (orders.Order, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	if !h.policy.AllowsAnyCustomer(principal, action) {
+		resource := authz.Resource{TenantID: principal.TenantID, CustomerID: principal.ID, ID: orderID.String()}
+		if err := h.policy.Authorize(ctx, principal, action, resource); err != nil {
+			return orders.Order{}, err
+		}
+
+		return h.orderStore.GetOrder(ctx, principal.TenantID, principal.ID, orderID.String())
+	}
+
+	order, err := h.orderStore.GetOrderByID(ctx, principal.TenantID, orderID.String())
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	resource := authz.Resource{TenantID: order.TenantID, CustomerID: order.CustomerID, ID: order.OrderID}
+	if err := h.policy.Authorize(ctx, principal, action, resource); err != nil {
+		return orders.Order{}, err
+	}
+
+	return order, nil
+}
+
+func (h *Handlers) CancelOrder(ctx context.Context, request api.CancelOrderRequestObject) (api.CancelOrderResponseObject, error) {
+	params := request.Params
+	order, err := h.cancelOrder(ctx, request.Orderid, params.IfMatch, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	restOrder, err := internalOrderToREST(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.CancelOrder200JSONResponse{
+		Body:    restOrder,
+		Headers: api.CancelOrder200ResponseHeaders{ETag: orderETag(order)},
+	}, nil
+}
+
+func (h *Handlers) CancelOrderV2(ctx context.Context, request api.CancelOrderV2RequestObject) (api.CancelOrderV2ResponseObject, error) {
+	params := request.Params
+	order, err := h.cancelOrder(ctx, request.Orderid, params.IfMatch, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	restOrder, err := internalOrderToRESTV2(order)
+	if err != nil {
+		return nil, err
 	}
+
+	return api.CancelOrderV2200JSONResponse{
+		Body:    restOrder,
+		Headers: api.CancelOrderV2200ResponseHeaders{ETag: orderETag(order)},
+	}, nil
+}
+
+func (h *Handlers) cancelOrder(ctx context.Context, orderID uuid.UUID, ifMatchHeader *string, req *api.CancelOrderRequest) (orders.Order, error) {
+	// The request body is optional, as the customer does not have to give a reason.
+	var reason string
+	if req != nil && req.Reason != nil {
+		reason = *req.Reason
+	}
+
+	return h.changeOrder(ctx, orderID, authz.ActionCancelOrder, ifMatchHeader, func(order orders.Order, version int) (orders.Order, error) {
+		return h.orderService.CancelOrder(ctx, order.TenantID, order.CustomerID, order.OrderID, reason, version)
+	})
 }
 
-func (l *ListOrderSummariesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	customerID := r.URL.Query().Get("customerID")
-	if customerID == "" {
-		writeError(w, "customerID not provided", http.StatusBadRequest, l.logger)
-		return
+func (h *Handlers) HoldOrder(ctx context.Context, request api.HoldOrderRequestObject) (api.HoldOrderResponseObject, error) {
+	// The request body is optional, as staff do not have to give a reason.
+	var reason string
+	if request.Body != nil && request.Body.Reason != nil {
+		reason = *request.Body.Reason
 	}
 
-	orders := l.orderStore.GetOrders(customerID)
+	order, err := h.changeOrder(ctx, request.Orderid, authz.ActionHoldOrder, request.Params.IfMatch, func(order orders.Order, version int) (orders.Order, error) {
+		return h.orderService.HoldOrder(ctx, order.TenantID, order.OrderID, reason, version)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	summary, err := internalOrdersToSummaries(orders)
+	restOrder, err := internalOrderToRESTV2(order)
 	if err != nil {
-		l.logger.WithError(err).WithField("orders", orders).Error("failed to convert orders from core to rest")
-		writeError(w, "An internal error occurred", http.StatusInternalServerError, l.logger)
-		return
+		return nil, err
 	}
 
-	respBody, err := json.Marshal(summary)
+	return api.HoldOrder200JSONResponse{
+		Body:    restOrder,
+		Headers: api.HoldOrder200ResponseHeaders{ETag: orderETag(order)},
+	}, nil
+}
+
+func (h *Handlers) SetOrderStatus(ctx context.Context, request api.SetOrderStatusRequestObject) (api.SetOrderStatusResponseObject, error) {
+	status, err := orderStatusV2ToInternal(request.Body.Status)
 	if err != nil {
-		l.logger.WithError(err).WithField("summary", summary).Error("failed to marshal order summaries")
-		writeError(w, "An internal error occurred", http.StatusInternalServerError, l.logger)
-		return
+		return nil, err
 	}
 
-	if _, err := w.Write(respBody); err != nil {
-		l.logger.WithError(err).WithField("resp-body", respBody).Error("failed to write response body")
-		writeError(w, "An internal error occurred", http.StatusInternalServerError, l.logger)
-		return
+	var reason string
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
 	}
+
+	order, err := h.changeOrder(ctx, request.Orderid, authz.ActionSetOrderStatus, request.Params.IfMatch, func(order orders.Order, version int) (orders.Order, error) {
+		return h.orderService.SetStatus(ctx, order.TenantID, order.OrderID, status, reason, version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restOrder, err := internalOrderToRESTV2(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.SetOrderStatus200JSONResponse{
+		Body:    restOrder,
+		Headers: api.SetOrderStatus200ResponseHeaders{ETag: orderETag(order)},
+	}, nil
 }
 
-func writeError(w http.ResponseWriter, msg string, status int, logger logging.Logger) {
-	restErr := Error{
-		Message: msg,
+// changeOrder makes a change to an order that the user may take the action on, if the order still
+// matches the If-Match header.
+func (h *Handlers) changeOrder(
+	ctx context.Context,
+	orderID uuid.UUID,
+	action authz.Action,
+	ifMatchHeader *string,
+	change func(order orders.Order, version int) (orders.Order, error),
+) (orders.Order, error) {
+	order, err := h.authorizedOrder(ctx, orderID, action)
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	if ifMatchHeader == nil {
+		return change(order, 0)
 	}
 
-	errResp, err := json.Marshal(restErr)
+	if !ifMatch(ifMatchHeader, orderETag(order)) {
+		return orders.Order{}, newOrderChanged(order.OrderID, nil)
+	}
+
+	changed, err := change(order, order.Version)
+	if errors.Is(err, orders.ErrVersionConflict) {
+		return orders.Order{}, newOrderChanged(order.OrderID, err)
+	}
+
+	return changed, err
+}
+
+func newOrderChanged(orderID string, err error) error {
+	msg := fmt.Sprintf("order %s does not match the If-Match header, as it has been changed", orderID)
+	return terrors.Presentable(newPreconditionFailed(msg, err))
+}
+
+// GetOrderEvents returns a response that streams the order's events when it is written. The
+// subscription is made before the order is read, so that no change made in between is missed.
+func (h *Handlers) GetOrderEvents(ctx context.Context, request api.GetOrderEventsRequestObject) (api.GetOrderEventsResponseObject, error) {
+	sub := h.feed.Subscribe(request.Orderid.String())
+	order, err := h.getOrder(ctx, request.Orderid)
 	if err != nil {
-		logger.WithError(err).WithField("rest-err", restErr).Error("failed to marshall error response")
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		sub.Close()
+		return nil, err
 	}
 
-	w.WriteHeader(status)
-	if _, err := w.Write(errResp); err != nil {
-		logger.WithError(err).WithField("err-resp", errResp).Error("failed to write error response")
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	var lastEventID int
+	if request.Params.LastEventID != nil {
+		lastEventID = *request.Params.LastEventID
 	}
+
+	return newOrderEventStream(ctx, sub, order, lastEventID, logctx.Logger(ctx, h.logger)), nil
+}
+
+// stringValue converts an optional parameter with an enum type back into a plain string.
+func stringValue[T ~string](value *T) *string {
+	if value == nil {
+		return nil
+	}
+
+	s := string(*value)
+	return &s
+}
+
+// stringValues converts an optional list parameter with an enum type back into plain strings.
+func stringValues[T ~string](values *[]T) []string {
+	if values == nil {
+		return nil
+	}
+
+	strs := make([]string, 0, len(*values))
+	for _, value := range *values {
+		strs = append(strs, string(value))
+	}
+
+	return strs
 }
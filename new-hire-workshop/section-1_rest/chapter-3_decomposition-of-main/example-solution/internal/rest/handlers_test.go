@@ -0,0 +1,230 @@
+package rest_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+const placeOrderBody = `{
+	"productId": "59a01784-e37b-42fd-9a2e-41dce95bccde",
+	"address": "1 Longdown Avenue",
+	"paymentId": "91b6c5d2-5aa0-4fab-b146-4b0959b7ae5f"
+}`
+
+func TestPlaceGetAndListOrders(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+
+	var placed api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: placeOrderBody}, http.StatusOK, &placed)
+	if placed.Status != api.OrderStatusV2Placed || placed.CustomerId.String() != customerID {
+		t.Errorf("placed order %+v, want a placed order for customer %s", placed, customerID)
+	}
+
+	orderPath := fmt.Sprintf("/api/v2/orders/%s?userid=%s", placed.OrderId, customerID)
+	var order api.OrderV2
+	resp := server.expect(t, request{method: http.MethodGet, path: orderPath}, http.StatusOK, &order)
+	if order.OrderId != placed.OrderId || len(order.DeliveryEntries) != 1 {
+		t.Errorf("got order %+v, want the placed order with one delivery entry", order)
+	}
+
+	etag := resp.Header.Get("ETag")
+	server.expect(t, request{method: http.MethodGet, path: orderPath, header: http.Header{"If-None-Match": {etag}}}, http.StatusNotModified, nil)
+
+	var list api.OrderSummaryListV2
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders?userid=" + customerID}, http.StatusOK, &list)
+	if len(list.OrderSummaries) != 1 || list.OrderSummaries[0].OrderId != placed.OrderId {
+		t.Errorf("listed %+v, want only the placed order", list.OrderSummaries)
+	}
+
+	// The first version of the API reports orders in its own models, which are checked too.
+	server.expect(t, request{method: http.MethodGet, path: fmt.Sprintf("/api/v1/orders/%s?userid=%s", placed.OrderId, customerID)}, http.StatusOK, nil)
+	server.expect(t, request{method: http.MethodGet, path: "/api/v1/orders?userid=" + customerID}, http.StatusOK, nil)
+}
+
+func TestCancelOrder(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+
+	var placed api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: placeOrderBody}, http.StatusOK, &placed)
+
+	cancelPath := fmt.Sprintf("/api/v2/orders/%s:cancel?userid=%s", placed.OrderId, customerID)
+	server.expect(t, request{method: http.MethodPost, path: cancelPath, header: http.Header{"If-Match": {`"99"`}}}, http.StatusPreconditionFailed, nil)
+
+	var cancelled api.OrderV2
+	server.expect(t, request{method: http.MethodPost, path: cancelPath, body: `{"reason": "changed my mind"}`}, http.StatusOK, &cancelled)
+	if cancelled.Status != api.OrderStatusV2Cancelled {
+		t.Errorf("cancelled order is %s, want %s", cancelled.Status, api.OrderStatusV2Cancelled)
+	}
+
+	server.expect(t, request{method: http.MethodPost, path: cancelPath}, http.StatusConflict, nil)
+}
+
+func TestOrderErrors(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+
+	tests := []struct {
+		name   string
+		req    request
+		status int
+	}{
+		{
+			name:   "no user",
+			req:    request{method: http.MethodGet, path: "/api/v2/orders"},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown order",
+			req:    request{method: http.MethodGet, path: fmt.Sprintf("/api/v2/orders/%s?userid=%s", uuid.NewString(), customerID)},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "order ID that is not a UUID",
+			req:    request{method: http.MethodGet, path: "/api/v2/orders/not-a-uuid?userid=" + customerID},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid order",
+			req:    request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: `{"productId": "not-a-uuid"}`},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "staff only operation",
+			req:    request{method: http.MethodPost, path: fmt.Sprintf("/api/v2/orders/%s:hold?userid=%s", uuid.NewString(), customerID)},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := server.expect(t, tt.req, tt.status, nil)
+			if got := resp.Header.Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("error response has content type %q, want a problem", got)
+			}
+		})
+	}
+
+	// The first version of the API sends its own error model unless the client asks for a problem.
+	v1Path := fmt.Sprintf("/api/v1/orders/%s?userid=%s", uuid.NewString(), customerID)
+	for accept, want := range map[string]string{"": "application/json", "application/problem+json": "application/problem+json"} {
+		resp := server.expect(t, request{method: http.MethodGet, path: v1Path, header: http.Header{"Accept": {accept}}}, http.StatusNotFound, nil)
+		if got := resp.Header.Get("Content-Type"); got != want {
+			t.Errorf("version 1 error response to Accept %q has content type %q, want %q", accept, got, want)
+		}
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+	webhooksPath := "/api/v1/webhooks?userid=" + customerID
+
+	var created api.CreatedWebhook
+	server.expect(t, request{method: http.MethodPost, path: webhooksPath, body: `{"url": "https://hooks.example.com/orders"}`}, http.StatusCreated, &created)
+
+	var list api.WebhookList
+	server.expect(t, request{method: http.MethodGet, path: webhooksPath}, http.StatusOK, &list)
+	if len(list.Webhooks) != 1 || list.Webhooks[0].Id != created.Id {
+		t.Fatalf("listed webhooks %+v, want only the created one", list.Webhooks)
+	}
+
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: placeOrderBody}, http.StatusOK, nil)
+
+	var deliveries api.WebhookDeliveryList
+	server.expect(t, request{method: http.MethodGet, path: fmt.Sprintf("/api/v1/webhooks/%s/deliveries?userid=%s", created.Id, customerID)}, http.StatusOK, &deliveries)
+	if len(deliveries.Deliveries) != 1 || deliveries.Deliveries[0].EventType != api.WebhookEventTypeOrderPlaced {
+		t.Errorf("webhook has deliveries %+v, want one for the placed order", deliveries.Deliveries)
+	}
+
+	webhookPath := fmt.Sprintf("/api/v1/webhooks/%s?userid=%s", created.Id, customerID)
+	server.expect(t, request{method: http.MethodDelete, path: webhookPath}, http.StatusNoContent, nil)
+	server.expect(t, request{method: http.MethodDelete, path: webhookPath}, http.StatusNotFound, nil)
+}
+
+// TestOrderEvents reads the start of an order's event stream. The stream is not checked against
+// the spec, but it must still reach the client as it is written rather than being held back.
+func TestOrderEvents(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+
+	var placed api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: placeOrderBody}, http.StatusOK, &placed)
+
+	resp, err := server.Client().Get(fmt.Sprintf("%s/api/v1/orders/%s/events?userid=%s", server.URL, placed.OrderId, customerID))
+	if err != nil {
+		t.Fatalf("requesting events: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("events request returned %d with content type %q, want a 200 event stream", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	// The stream starts with the delivery entry for placing the order and the order's status.
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	for len(events) < 2 && scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "event: ") {
+			events = append(events, strings.TrimPrefix(line, "event: "))
+		}
+	}
+
+	if len(events) != 2 || events[0] != "delivery" || events[1] != "status" {
+		t.Errorf("stream started with events %q, want a delivery and a status", events)
+	}
+}
+
+// TestOrderEventsEndWhenServerStops checks that an open event stream does not hold up stopping the
+// server.
+func TestOrderEventsEndWhenServerStops(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+
+	var placed api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders?userid=" + customerID, body: placeOrderBody}, http.StatusOK, &placed)
+
+	restServer, err := rest.NewServer(context.Background(), 0, server.router, logging.NewNoopLogger())
+	if err != nil {
+		t.Fatalf("NewServer returned error: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	go func() { _ = restServer.Serve(listener) }()
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/v1/orders/%s/events?userid=%s", listener.Addr(), placed.OrderId, customerID))
+	if err != nil {
+		t.Fatalf("requesting events: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The server is only stopped once the stream has started.
+	reader := bufio.NewReader(resp.Body)
+	if _, err := reader.ReadString('\n'); err != nil {
+		t.Fatalf("reading first event: %v", err)
+	}
+
+	// Stop gives up waiting for requests to finish after a timeout, returning an error.
+	if err := restServer.Stop(); err != nil {
+		t.Fatalf("Stop returned error with an event stream open: %v", err)
+	}
+
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		t.Errorf("event stream ended with error: %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotentBodySize is the largest body of a request with an idempotency key that is read
+	// to fingerprint it. Requests to place an order are far smaller than this.
+	maxIdempotentBodySize = 1 << 20
+)
+
+// Idempotency replays the stored response to a retried request with the same Idempotency-Key.
+type Idempotency struct {
+	keys   idempotency.KeyRepository
+	ttl    time.Duration
+	logger logging.Logger
+}
+
+// NewIdempotency keeps each key for the ttl, after which a request with the key is treated as a
+// new request.
+func NewIdempotency(keys idempotency.KeyRepository, ttl time.Duration, logger logging.Logger) *Idempotency {
+	return &Idempotency{
+		keys:   keys,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// Middleware handles the Idempotency-Key header of requests to place an order.
+func (i *Idempotency) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" || !isPlaceOrder(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		principal, err := principalFromContext(r.Context())
+		if err != nil {
+			writeErrorResponse(w, r, err, i.logger)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		if err != nil {
+			tooLargeErr := &http.MaxBytesError{}
+			if errors.As(err, &tooLargeErr) {
+				msg := fmt.Sprintf("is larger than %d bytes", tooLargeErr.Limit)
+				err = terrors.Presentable(terrors.NewInvalidSingleInput("request body", msg, err))
+			} else {
+				err = newInvalidBody(err)
+			}
+
+			writeErrorResponse(w, r, err, i.logger)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestFingerprint := fingerprint(r, body)
+		record, claimed, err := i.keys.Claim(r.Context(), idempotency.Record{
+			TenantID:    principal.TenantID,
+			CustomerID:  principal.ID,
+			Key:         key,
+			Fingerprint: requestFingerprint,
+			ExpiresAt:   time.Now().Add(i.ttl),
+		})
+		if err != nil {
+			writeErrorResponse(w, r, err, i.logger)
+			return
+		}
+
+		if !claimed {
+			i.replay(w, r, record, requestFingerprint)
+			return
+		}
+
+		// A handler that panics never gets to send a response, so the key is released for the
+		// client's retry, rather than being held as still in progress until it expires.
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				i.release(r.Context(), record)
+				panic(recovered)
+			}
+		}()
+
+		recorder := &recordingResponseWriter{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+		i.complete(r.Context(), record, recorder)
+	})
+}
+
+// replay sends the stored response for a key that has already been used.
+func (i *Idempotency) replay(w http.ResponseWriter, r *http.Request, record idempotency.Record, requestFingerprint string) {
+	if record.Fingerprint != requestFingerprint {
+		msg := fmt.Sprintf("idempotency key %s has already been used for a different request", record.Key)
+		writeErrorResponse(w, r, terrors.Presentable(newUnprocessableRequest(msg, nil)), i.logger)
+		return
+	}
+
+	if !record.Completed() {
+		msg := fmt.Sprintf("a request with idempotency key %s is still being processed", record.Key)
+		writeErrorResponse(w, r, terrors.Presentable(terrors.NewStateConflict(msg, nil)), i.logger)
+		return
+	}
+
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	if _, err := w.Write(record.Body); err != nil {
+		logctx.Logger(r.Context(), i.logger).WithError(err).WithField("key", record.Key).Error("failed to write replayed response")
+	}
+}
+
+// complete stores the response to a request whose key was claimed. A server error is not stored,
+// as the request may succeed if it is retried, so the key is released instead.
+func (i *Idempotency) complete(requestCtx context.Context, record idempotency.Record, recorder *recordingResponseWriter) {
+	if recorder.statusCode() >= http.StatusInternalServerError {
+		i.release(requestCtx, record)
+		return
+	}
+
+	ctx, cancel, logger := i.storeContext(requestCtx, record)
+	defer cancel()
+
+	record.StatusCode = recorder.statusCode()
+	record.ContentType = recorder.Header().Get("Content-Type")
+	record.Body = recorder.body.Bytes()
+	if err := i.keys.Complete(ctx, record); err != nil {
+		logger.WithError(err).Error("failed to store response for idempotency key")
+	}
+}
+
+// release releases a claimed key, so that the request can be retried with it.
+func (i *Idempotency) release(requestCtx context.Context, record idempotency.Record) {
+	ctx, cancel, logger := i.storeContext(requestCtx, record)
+	defer cancel()
+
+	if err := i.keys.Release(ctx, record.TenantID, record.CustomerID, record.Key); err != nil {
+		logger.WithError(err).Error("failed to release idempotency key")
+	}
+}
+
+// storeContext returns the context to update a claimed key's record with, which outlives the
+// request.
+func (i *Idempotency) storeContext(requestCtx context.Context, record idempotency.Record) (context.Context, context.CancelFunc, logging.Logger) {
+	logger := logctx.Logger(requestCtx, i.logger).WithFields(logging.Fields{
+		"customer-id": record.CustomerID,
+		"key":         record.Key,
+	})
+
+	ctx, cancel := context.WithTimeout(logging.ContextWithLogger(context.Background(), logger), 5*time.Second)
+	return ctx, cancel, logger
+}
+
+// isPlaceOrder reports whether a request is to place an order, in any version of the API.
+func isPlaceOrder(r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		return false
+	}
+
+	for _, version := range APIVersions {
+		if r.URL.Path == fmt.Sprintf("/api/v%d/orders", version) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fingerprint identifies a request by its path and its body, with a JSON body re-encoded first.
+func fingerprint(r *http.Request, body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if canonical, err := json.Marshal(decoded); err == nil {
+			body = canonical
+		}
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(r.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,142 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+func TestIdempotencyReplaysResponse(t *testing.T) {
+	server := newTestServer(t)
+	customerID := uuid.NewString()
+	path := "/api/v2/orders?userid=" + customerID
+	key := http.Header{"Idempotency-Key": {"key"}}
+
+	var placed api.OrderSummaryV2
+	resp := server.expect(t, request{method: http.MethodPost, path: path, body: placeOrderBody, header: key}, http.StatusOK, &placed)
+	if replayed := resp.Header.Get("Idempotent-Replayed"); replayed != "" {
+		t.Errorf("first request has Idempotent-Replayed %q, want none", replayed)
+	}
+
+	// The retry sends the same body with its fields in another order, which is the same request.
+	retryBody := `{"paymentId": "91b6c5d2-5aa0-4fab-b146-4b0959b7ae5f", "productId": "59a01784-e37b-42fd-9a2e-41dce95bccde", "address": "1 Longdown Avenue"}`
+	var retried api.OrderSummaryV2
+	resp = server.expect(t, request{method: http.MethodPost, path: path, body: retryBody, header: key}, http.StatusOK, &retried)
+	if replayed := resp.Header.Get("Idempotent-Replayed"); replayed != "true" {
+		t.Errorf("retry has Idempotent-Replayed %q, want true", replayed)
+	}
+	if retried.OrderId != placed.OrderId {
+		t.Errorf("retry returned order %s, want the first response's order %s", retried.OrderId, placed.OrderId)
+	}
+
+	var list api.OrderSummaryListV2
+	server.expect(t, request{method: http.MethodGet, path: path}, http.StatusOK, &list)
+	if len(list.OrderSummaries) != 1 {
+		t.Errorf("customer has %d orders, want the retry not to have placed another", len(list.OrderSummaries))
+	}
+}
+
+func TestIdempotencyRejectsKeyReusedForAnotherRequest(t *testing.T) {
+	server := newTestServer(t)
+	path := "/api/v2/orders?userid=" + uuid.NewString()
+	key := http.Header{"Idempotency-Key": {"key"}}
+
+	server.expect(t, request{method: http.MethodPost, path: path, body: placeOrderBody, header: key}, http.StatusOK, nil)
+
+	otherBody := strings.Replace(placeOrderBody, "1 Longdown Avenue", "2 Longdown Avenue", 1)
+	server.expect(t, request{method: http.MethodPost, path: path, body: otherBody, header: key}, http.StatusUnprocessableEntity, nil)
+}
+
+func TestIdempotencyRejectsRetryWhileInProgress(t *testing.T) {
+	started, finish := make(chan struct{}), make(chan struct{})
+	handler := idempotentHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-finish
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	path := "/api/v2/orders?userid=" + uuid.NewString()
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(first, newIdempotentRequest(path, "{}"))
+	}()
+
+	<-started
+	retry := httptest.NewRecorder()
+	handler.ServeHTTP(retry, newIdempotentRequest(path, "{}"))
+	if retry.Code != http.StatusConflict {
+		t.Errorf("retry while the first request is in progress returned %d, want %d", retry.Code, http.StatusConflict)
+	}
+
+	close(finish)
+	<-done
+	if first.Code != http.StatusOK {
+		t.Errorf("first request returned %d, want %d", first.Code, http.StatusOK)
+	}
+}
+
+func TestIdempotencyReleasesKeyWhenHandlerPanics(t *testing.T) {
+	panicking := true
+	handler := idempotentHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if panicking {
+			panic("handler failed")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	path := "/api/v2/orders?userid=" + uuid.NewString()
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != "handler failed" {
+				t.Errorf("recovered %v, want the handler's panic to be passed on", recovered)
+			}
+		}()
+		handler.ServeHTTP(httptest.NewRecorder(), newIdempotentRequest(path, "{}"))
+	}()
+
+	// Had the key still been held, the retry would be told the first request is in progress.
+	panicking = false
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newIdempotentRequest(path, "{}"))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("retry returned %d, want %d: %s", recorder.Code, http.StatusOK, recorder.Body)
+	}
+}
+
+func TestIdempotencyRejectsLargeBodies(t *testing.T) {
+	handler := idempotentHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler was called for a request with a body that is too large")
+	}))
+
+	body := `{"address": "` + strings.Repeat("a", 2<<20) + `"}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newIdempotentRequest("/api/v2/orders?userid="+uuid.NewString(), body))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("request returned %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+// idempotentHandler handles requests with the idempotency middleware in front of the handler,
+// taking the user from the userid query parameter.
+func idempotentHandler(next http.Handler) http.Handler {
+	logger := logging.NewNoopLogger()
+	idempotencyKeys := rest.NewIdempotency(idempotency.NewKeyStore(), time.Hour, logger)
+	return rest.NewQueryParamAuthenticator(logger).Middleware(idempotencyKeys.Middleware(next))
+}
+
+func newIdempotentRequest(path, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Idempotency-Key", "key")
+	return r
+}
@@ -0,0 +1,334 @@
+package rest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+)
+
+const (
+	// keySetMaxAge is how long a key set from a URL is used for before it is fetched again, so that
+	// keys the identity provider has retired stop being trusted.
+	keySetMaxAge = time.Hour
+	// keySetMinAge is how long a key set from a URL is used for before a token signed with a key
+	// that is not in it makes it be fetched again. It stops tokens with made up key IDs from having
+	// the key set fetched for every request.
+	keySetMinAge       = time.Minute
+	keySetFetchTimeout = 10 * time.Second
+	maxKeySetSize      = 1 << 20
+	minRSAKeyBits      = 2048
+)
+
+// KeySet is a JSON Web Key Set of the RSA and P-256 EC keys that bearer tokens are signed with.
+type KeySet struct {
+	// fetch reads the key set from its URL. It is nil for a key set from a file, which is only read
+	// when it is loaded.
+	fetch  func(ctx context.Context) ([]byte, error)
+	logger logging.Logger
+
+	mu   sync.Mutex
+	keys []jsonWebKey
+	// fetchedAt is when the key set was last fetched, or when the last attempt to fetch it started,
+	// so that an identity provider that is down is not asked for the key set on every request.
+	fetchedAt time.Time
+	// refreshing is closed when the fetch that is under way finishes, or is nil if there is none.
+	refreshing chan struct{}
+}
+
+// jsonWebKey is a key from a key set that tokens can be verified with.
+type jsonWebKey struct {
+	id string
+	// alg is the algorithm the key is for, or empty if the key set does not say.
+	alg string
+	key crypto.PublicKey
+}
+
+// LoadKeySet loads a key set from a local file, or from an http:// or https:// URL, which is
+// fetched again as it ages.
+func LoadKeySet(ctx context.Context, location string, logger logging.Logger) (*KeySet, error) {
+	keySet := &KeySet{
+		logger: logger.WithField("jwks", location),
+	}
+
+	var data []byte
+	var err error
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		client := &http.Client{Timeout: keySetFetchTimeout}
+		keySet.fetch = func(ctx context.Context) ([]byte, error) {
+			return fetchKeySet(ctx, client, location)
+		}
+		data, err = keySet.fetch(ctx)
+	} else {
+		data, err = os.ReadFile(location)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading JWKS %s: %w", location, err)
+	}
+
+	if keySet.keys, err = parseKeySet(data); err != nil {
+		return nil, fmt.Errorf("loading JWKS %s: %w", location, err)
+	}
+	keySet.fetchedAt = time.Now()
+
+	return keySet, nil
+}
+
+// key returns the key with the ID that verifies tokens signed with the algorithm. A token without
+// a key ID can only be verified if there is just one key in the set that it could be signed with.
+func (s *KeySet) key(ctx context.Context, id, alg string) (crypto.PublicKey, error) {
+	if s.fetch != nil && s.age() > keySetMaxAge {
+		s.refresh(ctx, keySetMaxAge)
+	}
+
+	key, found := s.find(id, alg)
+	if !found && s.fetch != nil {
+		s.refresh(ctx, keySetMinAge)
+		key, found = s.find(id, alg)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("JWKS has no %s key with ID %q", alg, id)
+	}
+
+	return key, nil
+}
+
+func (s *KeySet) find(id, alg string) (crypto.PublicKey, bool) {
+	// The keys are replaced rather than changed when the key set is fetched again, so they can be
+	// searched without holding the lock.
+	s.mu.Lock()
+	keys := s.keys
+	s.mu.Unlock()
+
+	var matches []crypto.PublicKey
+	for _, key := range keys {
+		if (id == "" || key.id == id) && (key.alg == "" || key.alg == alg) && keyFitsAlg(key.key, alg) {
+			matches = append(matches, key.key)
+		}
+	}
+
+	if len(matches) == 0 || (id == "" && len(matches) > 1) {
+		return nil, false
+	}
+
+	return matches[0], true
+}
+
+// age returns how long it has been since the key set was last fetched, or since a fetch started.
+func (s *KeySet) age() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return time.Since(s.fetchedAt)
+}
+
+// refresh fetches the key set again if it is older than maxAge, waiting for a fetch that is
+// already under way rather than starting another.
+func (s *KeySet) refresh(ctx context.Context, maxAge time.Duration) {
+	s.mu.Lock()
+	if done := s.refreshing; done != nil {
+		s.mu.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		return
+	}
+
+	if time.Since(s.fetchedAt) <= maxAge {
+		s.mu.Unlock()
+		return
+	}
+
+	done := make(chan struct{})
+	s.refreshing = done
+	s.fetchedAt = time.Now()
+	s.mu.Unlock()
+
+	keys := s.fetchKeys()
+
+	s.mu.Lock()
+	if keys != nil {
+		s.keys = keys
+	}
+	s.refreshing = nil
+	s.mu.Unlock()
+	close(done)
+}
+
+// fetchKeys fetches and parses the key set, logging any error and returning nil.
+func (s *KeySet) fetchKeys() []jsonWebKey {
+	ctx, cancel := context.WithTimeout(context.Background(), keySetFetchTimeout)
+	defer cancel()
+
+	data, err := s.fetch(ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to fetch JWKS")
+		return nil
+	}
+
+	keys, err := parseKeySet(data)
+	if err != nil {
+		s.logger.WithError(err).Error("fetched JWKS is not valid")
+		return nil
+	}
+
+	return keys
+}
+
+func fetchKeySet(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS URL responded with %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize))
+}
+
+// parseKeySet reads the keys that can verify tokens from a key set, returning an error if there
+// are none, or if any of them are malformed.
+func parseKeySet(data []byte) ([]jsonWebKey, error) {
+	var keySet struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			Alg string `json:"alg"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &keySet); err != nil {
+		return nil, fmt.Errorf("parsing JWKS: %w", err)
+	}
+
+	var keys []jsonWebKey
+	for _, jwk := range keySet.Keys {
+		// Keys for encryption, and for algorithms that tokens may not be signed with, are left for
+		// whatever else the key set is used for.
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		var err error
+		switch {
+		case jwk.Kty == "RSA" && (jwk.Alg == "" || jwk.Alg == "RS256"):
+			key, err = parseRSAKey(jwk.N, jwk.E)
+		case jwk.Kty == "EC" && jwk.Crv == "P-256" && (jwk.Alg == "" || jwk.Alg == "ES256"):
+			key, err = parseP256Key(jwk.X, jwk.Y)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing JWKS key %q: %w", jwk.Kid, err)
+		}
+
+		keys = append(keys, jsonWebKey{id: jwk.Kid, alg: jwk.Alg, key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS has no RS256 or ES256 signing keys")
+	}
+
+	return keys, nil
+}
+
+func parseRSAKey(n, e string) (*rsa.PublicKey, error) {
+	modulus, err := decodeKeyParam("n", n)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := decodeKeyParam("e", e)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &rsa.PublicKey{N: modulus}
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("RSA exponent is out of range")
+	}
+	key.E = int(exponent.Int64())
+
+	if key.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+	}
+
+	return key, nil
+}
+
+func parseP256Key(x, y string) (*ecdsa.PublicKey, error) {
+	xInt, err := decodeKeyParam("x", x)
+	if err != nil {
+		return nil, err
+	}
+
+	yInt, err := decodeKeyParam("y", y)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := elliptic.P256()
+	if !curve.IsOnCurve(xInt, yInt) {
+		return nil, errors.New("EC key is not on the P-256 curve")
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: xInt, Y: yInt}, nil
+}
+
+// decodeKeyParam decodes one of a key's numbers, which are base64url encoded big-endian bytes.
+func decodeKeyParam(name, value string) (*big.Int, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s is missing", name)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid base64url: %w", name, err)
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+// keyFitsAlg reports whether a key from a key set can verify tokens signed with the algorithm.
+func keyFitsAlg(key crypto.PublicKey, alg string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return alg == "RS256"
+	case *ecdsa.PublicKey:
+		return alg == "ES256"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+)
+
+func TestKeySetFetchesRotatedKeysOnce(t *testing.T) {
+	oldKey := generateRSAKey(t)
+	newKey := generateRSAKey(t)
+
+	var fetches int32
+	release := make(chan struct{})
+	jwks := newKeySetJSON(t, map[string]*rsa.PrivateKey{"old": oldKey})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first fetch is the one made while loading the key set, and the rest are held up
+		// until the test lets them through.
+		if atomic.AddInt32(&fetches, 1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(jwks)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	keySet, err := LoadKeySet(ctx, server.URL, logging.NewNoopLogger())
+	if err != nil {
+		t.Fatalf("LoadKeySet returned error: %v", err)
+	}
+
+	// The identity provider rotates its keys, and the key set is old enough to be fetched again
+	// for a token signed with a key that is not in it.
+	jwks = newKeySetJSON(t, map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey})
+	keySet.mu.Lock()
+	keySet.fetchedAt = time.Now().Add(-2 * keySetMinAge)
+	keySet.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := keySet.key(ctx, "new", "RS256"); err != nil {
+				t.Errorf("key returned error for rotated key: %v", err)
+			}
+		}()
+	}
+
+	// A token signed with a key that is already known is verified while the fetch is under way.
+	for atomic.LoadInt32(&fetches) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := keySet.key(ctx, "old", "RS256"); err != nil {
+		t.Errorf("key returned error for known key: %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&fetches); got != 2 {
+		t.Errorf("key set was fetched %d times, want once when loaded and once for the rotated key", got)
+	}
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatalf("generating RSA key: %v", err)
+	}
+
+	return key
+}
+
+func newKeySetJSON(t *testing.T, keys map[string]*rsa.PrivateKey) []byte {
+	t.Helper()
+
+	type jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+
+	var keySet struct {
+		Keys []jwk `json:"keys"`
+	}
+	for id, key := range keys {
+		keySet.Keys = append(keySet.Keys, jwk{
+			Kty: "RSA",
+			Kid: id,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+
+	data, err := json.Marshal(keySet)
+	if err != nil {
+		t.Fatalf("encoding JWKS: %v", err)
+	}
+
+	return data
+}
@@ -1,49 +1,143 @@
 package rest
 
 import (
-	"ecommerce-workshop/internal/orders"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
 )
 
-type OrderStatus string
+// The REST models themselves are generated from the API spec into the api package, so this file
+// only converts between them and the orders package.
 
-const (
-	OrderStatusPlaced    OrderStatus = "Placed"
-	OrderStatusDelivered OrderStatus = "Delivered"
-	OrderStatusTransit   OrderStatus = "In transit"
-)
+// validatePlaceOrderRequest checks all the fields of the request at once, so the consumer is told
+// about every problem with their request rather than having to fix them one at a time.
+func validatePlaceOrderRequest(req api.PlaceOrderRequest) error {
+	var invalid []terrors.InputAndMsg
+	if req.ProductId == uuid.Nil {
+		invalid = append(invalid, terrors.InputAndMsg{Input: "productId", Msg: "must not be empty"})
+	}
+
+	if req.Address == "" {
+		invalid = append(invalid, terrors.InputAndMsg{Input: "address", Msg: "must not be empty"})
+	}
+
+	if req.PaymentId == uuid.Nil {
+		invalid = append(invalid, terrors.InputAndMsg{Input: "paymentId", Msg: "must not be empty"})
+	}
+
+	if len(invalid) > 0 {
+		return terrors.Presentable(terrors.NewInvalidInput(invalid, nil))
+	}
 
-type ListOrdersResponse struct {
-	OrderSummaries []OrderSummary `json:"orderSummaries"`
+	return nil
 }
 
-type OrderSummary struct {
-	OrderID    string      `json:"orderId"`
-	CustomerID string      `json:"customerId"`
-	ProductID  string      `json:"productId"`
-	Status     OrderStatus `json:"status"`
+// orderIDs holds the IDs of an order parsed into the UUIDs that the API represents them as.
+type orderIDs struct {
+	orderID    uuid.UUID
+	customerID uuid.UUID
+	productID  uuid.UUID
+	paymentID  uuid.UUID
 }
 
-type Error struct {
-	Message string `json:"message"`
+func parseOrderIDs(order orders.Order) (orderIDs, error) {
+	var ids orderIDs
+	for _, id := range []struct {
+		name  string
+		value string
+		dest  *uuid.UUID
+	}{
+		{"order ID", order.OrderID, &ids.orderID},
+		{"customer ID", order.CustomerID, &ids.customerID},
+		{"product ID", order.ProductID, &ids.productID},
+		{"payment ID", order.PaymentID, &ids.paymentID},
+	} {
+		parsed, err := uuid.Parse(id.value)
+		if err != nil {
+			return orderIDs{}, fmt.Errorf("order %s has an invalid %s %q: %w", order.OrderID, id.name, id.value, err)
+		}
+
+		*id.dest = parsed
+	}
+
+	return ids, nil
 }
 
-func internalOrdersToSummaries(orders []orders.Order) (ListOrdersResponse, error) {
-	resp := ListOrdersResponse{
-		OrderSummaries: make([]OrderSummary, 0, len(orders)),
+func internalOrdersToSummaries(orders []orders.Order, nextPageToken string) (api.OrderSummaryList, error) {
+	resp := api.OrderSummaryList{
+		OrderSummaries: make([]api.OrderSummary, 0, len(orders)),
+		NextPageToken:  optionalString(nextPageToken),
 	}
 
 	for _, order := range orders {
-		status, err := orderStatusToREST(order.Status)
+		summary, err := internalOrderToSummary(order)
 		if err != nil {
-			return ListOrdersResponse{}, err
+			return api.OrderSummaryList{}, err
 		}
 
-		summary := OrderSummary{
-			OrderID:    order.OrderID,
-			CustomerID: order.CustomerID,
-			ProductID:  order.ProductID,
-			Status:     status,
+		resp.OrderSummaries = append(resp.OrderSummaries, summary)
+	}
+
+	return resp, nil
+}
+
+func internalOrderToSummary(order orders.Order) (api.OrderSummary, error) {
+	ids, err := parseOrderIDs(order)
+	if err != nil {
+		return api.OrderSummary{}, err
+	}
+
+	status, err := orderStatusToREST(order.Status)
+	if err != nil {
+		return api.OrderSummary{}, err
+	}
+
+	return api.OrderSummary{
+		OrderId:    ids.orderID,
+		CustomerId: ids.customerID,
+		ProductId:  ids.productID,
+		Status:     status,
+	}, nil
+}
+
+func internalOrderToREST(order orders.Order) (api.Order, error) {
+	ids, err := parseOrderIDs(order)
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	status, err := orderStatusToREST(order.Status)
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return api.Order{
+		OrderId:         ids.orderID,
+		CustomerId:      ids.customerID,
+		ProductId:       ids.productID,
+		PaymentId:       ids.paymentID,
+		Status:          status,
+		Address:         order.Address,
+		DeliveryEntries: internalDeliveryEntriesToREST(order.DeliveryEntries),
+		OrderedAt:       order.OrderedAt,
+		DeliveredAt:     deliveredAt(order),
+	}, nil
+}
+
+func internalOrdersToSummariesV2(orders []orders.Order, nextPageToken string) (api.OrderSummaryListV2, error) {
+	resp := api.OrderSummaryListV2{
+		OrderSummaries: make([]api.OrderSummaryV2, 0, len(orders)),
+		NextPageToken:  optionalString(nextPageToken),
+	}
+
+	for _, order := range orders {
+		summary, err := internalOrderToSummaryV2(order)
+		if err != nil {
+			return api.OrderSummaryListV2{}, err
 		}
 
 		resp.OrderSummaries = append(resp.OrderSummaries, summary)
@@ -52,15 +146,126 @@ func internalOrdersToSummaries(orders []orders.Order) (ListOrdersResponse, error
 	return resp, nil
 }
 
-func orderStatusToREST(status orders.OrderStatus) (OrderStatus, error) {
+func internalOrderToSummaryV2(order orders.Order) (api.OrderSummaryV2, error) {
+	ids, err := parseOrderIDs(order)
+	if err != nil {
+		return api.OrderSummaryV2{}, err
+	}
+
+	status, err := orderStatusToRESTV2(order.Status)
+	if err != nil {
+		return api.OrderSummaryV2{}, err
+	}
+
+	return api.OrderSummaryV2{
+		OrderId:      ids.orderID,
+		CustomerId:   ids.customerID,
+		ProductId:    ids.productID,
+		Status:       status,
+		StatusReason: optionalString(order.StatusReason),
+	}, nil
+}
+
+func internalOrderToRESTV2(order orders.Order) (api.OrderV2, error) {
+	ids, err := parseOrderIDs(order)
+	if err != nil {
+		return api.OrderV2{}, err
+	}
+
+	status, err := orderStatusToRESTV2(order.Status)
+	if err != nil {
+		return api.OrderV2{}, err
+	}
+
+	return api.OrderV2{
+		OrderId:         ids.orderID,
+		CustomerId:      ids.customerID,
+		ProductId:       ids.productID,
+		PaymentId:       ids.paymentID,
+		Status:          status,
+		StatusReason:    optionalString(order.StatusReason),
+		Address:         order.Address,
+		DeliveryEntries: internalDeliveryEntriesToREST(order.DeliveryEntries),
+		OrderedAt:       order.OrderedAt,
+		DeliveredAt:     deliveredAt(order),
+	}, nil
+}
+
+func internalDeliveryEntriesToREST(deliveryEntries []orders.DeliveryEntry) []api.DeliveryEntry {
+	entries := make([]api.DeliveryEntry, 0, len(deliveryEntries))
+	for _, entry := range deliveryEntries {
+		entries = append(entries, api.DeliveryEntry{
+			Message:   entry.Message,
+			Timestamp: entry.Timestamp,
+		})
+	}
+
+	return entries
+}
+
+// deliveredAt returns nil for an order which has not been delivered yet, so that it is rendered
+// as null rather than as the zero time.
+func deliveredAt(order orders.Order) *time.Time {
+	if order.DeliveredAt.IsZero() {
+		return nil
+	}
+
+	return &order.DeliveredAt
+}
+
+// optionalString returns nil for an empty string, so that optional fields are left out of the
+// response rather than being sent empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func orderStatusToREST(status orders.OrderStatus) (api.OrderStatus, error) {
 	switch status {
 	case orders.OrderStatusPlaced, orders.OrderStatusCancelled, orders.OrderStatusOnHold:
-		return OrderStatusPlaced, nil
+		return api.OrderStatusPlaced, nil
+	case orders.OrderStatusDelivered:
+		return api.OrderStatusDelivered, nil
+	case orders.OrderStatusTransit:
+		return api.OrderStatusInTransit, nil
+	}
+
+	return api.OrderStatus(""), fmt.Errorf("Unexpected status value: %s", status)
+}
+
+func orderStatusToRESTV2(status orders.OrderStatus) (api.OrderStatusV2, error) {
+	switch status {
+	case orders.OrderStatusPlaced:
+		return api.OrderStatusV2Placed, nil
 	case orders.OrderStatusDelivered:
-		return OrderStatusDelivered, nil
+		return api.OrderStatusV2Delivered, nil
 	case orders.OrderStatusTransit:
-		return OrderStatusTransit, nil
+		return api.OrderStatusV2InTransit, nil
+	case orders.OrderStatusCancelled:
+		return api.OrderStatusV2Cancelled, nil
+	case orders.OrderStatusOnHold:
+		return api.OrderStatusV2OnHold, nil
+	}
+
+	return api.OrderStatusV2(""), fmt.Errorf("Unexpected status value: %s", status)
+}
+
+func orderStatusV2ToInternal(status api.OrderStatusV2) (orders.OrderStatus, error) {
+	switch status {
+	case api.OrderStatusV2Placed:
+		return orders.OrderStatusPlaced, nil
+	case api.OrderStatusV2Delivered:
+		return orders.OrderStatusDelivered, nil
+	case api.OrderStatusV2InTransit:
+		return orders.OrderStatusTransit, nil
+	case api.OrderStatusV2Cancelled:
+		return orders.OrderStatusCancelled, nil
+	case api.OrderStatusV2OnHold:
+		return orders.OrderStatusOnHold, nil
 	}
 
-	return OrderStatus(""), fmt.Errorf("Unexpected status value: %s", status)
+	return "", terrors.Presentable(terrors.NewInvalidSingleInput("status", fmt.Sprintf("unknown status %q", status), nil))
 }
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+const (
+	sortOrderedAtAscending  = "orderedAt"
+	sortOrderedAtDescending = "-orderedAt"
+)
+
+// pageToken is the contents of the opaque page tokens given to consumers. It is encoded as base64
+// JSON so that it is URL safe, and so that consumers are not tempted to build their own tokens.
+type pageToken struct {
+	OrderedAt time.Time `json:"orderedAt"`
+	OrderID   string    `json:"orderId"`
+}
+
+func encodePageToken(cursor *orders.Cursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	token, err := json.Marshal(pageToken{
+		OrderedAt: cursor.OrderedAt,
+		OrderID:   cursor.OrderID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func decodePageToken(encoded string) (*orders.Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(decoded, &token); err != nil {
+		return nil, err
+	}
+
+	return &orders.Cursor{
+		OrderedAt: token.OrderedAt,
+		OrderID:   token.OrderID,
+	}, nil
+}
+
+// listParams holds the filtering, sorting and pagination parameters of a list request.
+type listParams struct {
+	Limit         *int
+	PageToken     *string
+	Statuses      []string
+	OrderedFrom   *time.Time
+	OrderedBefore *time.Time
+	Sort          *string
+}
+
+// parseListOptions converts the parameters of a list request into list options.
+func parseListOptions(params listParams, version APIVersion) (orders.ListOptions, error) {
+	opts := orders.ListOptions{
+		Limit: defaultPageSize,
+	}
+
+	var invalid []terrors.InputAndMsg
+	addInvalid := func(input, msg string) {
+		invalid = append(invalid, terrors.InputAndMsg{Input: input, Msg: msg})
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxPageSize {
+			addInvalid("limit", "must be a number between 1 and "+strconv.Itoa(maxPageSize))
+		} else {
+			opts.Limit = *params.Limit
+		}
+	}
+
+	if params.PageToken != nil && *params.PageToken != "" {
+		cursor, err := decodePageToken(*params.PageToken)
+		if err != nil {
+			addInvalid("pageToken", "is not a valid page token")
+		} else {
+			opts.After = cursor
+		}
+	}
+
+	for _, status := range params.Statuses {
+		statuses, ok := version.statusFilter(status)
+		if !ok {
+			addInvalid("status", "is not a valid order status")
+			continue
+		}
+
+		opts.Statuses = append(opts.Statuses, statuses...)
+	}
+
+	if params.OrderedFrom != nil {
+		opts.OrderedFrom = *params.OrderedFrom
+	}
+
+	if params.OrderedBefore != nil {
+		opts.OrderedBefore = *params.OrderedBefore
+	}
+
+	if params.Sort != nil {
+		switch *params.Sort {
+		case sortOrderedAtAscending:
+		case sortOrderedAtDescending:
+			opts.Descending = true
+		default:
+			addInvalid("sort", "must be one of "+sortOrderedAtAscending+" or "+sortOrderedAtDescending)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return orders.ListOptions{}, terrors.Presentable(terrors.NewInvalidInput(invalid, nil))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,271 @@
+package rest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+)
+
+const (
+	rateLimitLimitHeader     = "RateLimit-Limit"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+	apiKeyHeader             = "X-API-Key"
+)
+
+// RateLimits are the limits on how often clients can make requests.
+type RateLimits struct {
+	// Client limits the requests from each IP address, whoever they are from. It is checked before
+	// requests are authenticated, so that it also limits clients whose requests are rejected.
+	Client ratelimit.Limit
+	// APIKey limits the requests made with each API key in the X-API-Key header, on top of the
+	// Client limit. Requests without a key, or every request if it is zero, are not limited by it.
+	APIKey ratelimit.Limit
+	// User limits the requests from each user, to every operation together.
+	User ratelimit.Limit
+	// Operations are further limits on each user's requests to particular operations, keyed by
+	// the operation's ID in the API spec.
+	Operations map[string]ratelimit.Limit
+}
+
+// RateLimiter rejects the requests over the RateLimits with a 429, and lets requests through if
+// their buckets cannot be read.
+type RateLimiter struct {
+	buckets ratelimit.BucketRepository
+	limits  RateLimits
+	// operations maps the method and path template of each route in the spec to the ID of its
+	// operation.
+	operations map[string]string
+	logger     logging.Logger
+}
+
+// NewRateLimiter returns an error if any of the operations in limits is not in the spec, as a limit
+// that is never applied because of a typo would otherwise go unnoticed.
+func NewRateLimiter(buckets ratelimit.BucketRepository, limits RateLimits, spec *openapi3.T, logger logging.Logger) (*RateLimiter, error) {
+	operations := map[string]string{}
+	known := map[string]bool{}
+	for path, item := range spec.Paths {
+		for method, operation := range item.Operations() {
+			operations[routeKey(method, path)] = operation.OperationID
+			known[operation.OperationID] = true
+		}
+	}
+
+	var unknown []string
+	for operationID := range limits.Operations {
+		if !known[operationID] {
+			unknown = append(unknown, operationID)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("rate limits are given for operations that are not in the API spec: %s", strings.Join(unknown, ", "))
+	}
+
+	return &RateLimiter{
+		buckets:    buckets,
+		limits:     limits,
+		operations: operations,
+		logger:     logger,
+	}, nil
+}
+
+// ClientMiddleware limits the requests from each IP address and with each API key. It must run
+// before requests are authenticated, and leaves Middleware to take the tokens of the requests
+// that it lets through, so that a request refused by a user's limit does not use up its client's.
+func (l *RateLimiter) ClientMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		buckets := []bucket{{key: "client:" + ip, limit: l.limits.Client}}
+		if key := r.Header.Get(apiKeyHeader); key != "" && l.limits.APIKey.Requests > 0 {
+			// The key is hashed so that it is not stored where anyone who can read the buckets
+			// could use it.
+			hash := sha256.Sum256([]byte(key))
+			buckets = append(buckets, bucket{key: "api-key:" + hex.EncodeToString(hash[:]), limit: l.limits.APIKey})
+		}
+
+		if !l.check(w, r, buckets) {
+			return
+		}
+
+		pending := &clientBuckets{buckets: buckets}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientBucketsKey{}, pending)))
+
+		// A request that never reached Middleware, such as one that could not be authenticated,
+		// still counts against its client's limits.
+		if !pending.claimed {
+			l.take(r, buckets)
+		}
+	})
+}
+
+// Middleware limits the requests from each user, and takes the tokens that ClientMiddleware left
+// for it along with theirs. It must run after requests are authenticated.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := principalFromContext(r.Context())
+		if err != nil {
+			writeErrorResponse(w, r, err, l.logger)
+			return
+		}
+
+		var buckets []bucket
+		if pending, ok := r.Context().Value(clientBucketsKey{}).(*clientBuckets); ok {
+			buckets = append(buckets, pending.buckets...)
+			pending.claimed = true
+		}
+
+		// The tenant is part of the key, as user IDs are only unique within a tenant.
+		user := fmt.Sprintf("user:%s/%s", principal.TenantID, principal.ID)
+		buckets = append(buckets, bucket{key: user, limit: l.limits.User})
+		if operationID := l.operationID(r); operationID != "" {
+			if limit, ok := l.limits.Operations[operationID]; ok {
+				buckets = append(buckets, bucket{key: user + ":" + operationID, limit: limit})
+			}
+		}
+
+		if l.allow(w, r, buckets) {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// bucket is a token bucket that a request must take a token from to be allowed.
+type bucket struct {
+	key   string
+	limit ratelimit.Limit
+}
+
+// clientBucketsKey is the context key for the clientBuckets of a request.
+type clientBucketsKey struct{}
+
+// clientBuckets are the buckets that ClientMiddleware checked for a request, which are claimed by
+// Middleware when it takes their tokens.
+type clientBuckets struct {
+	buckets []bucket
+	claimed bool
+}
+
+// allow checks that every bucket has a token before taking one from each, so that a request that
+// is refused does not use up the tokens of the buckets that would have let it through. It writes
+// the error response and returns false if any of them has none left.
+func (l *RateLimiter) allow(w http.ResponseWriter, r *http.Request, buckets []bucket) bool {
+	if !l.check(w, r, buckets) {
+		return false
+	}
+
+	// Another request may take the last token of a bucket after it is checked, in which case the
+	// tokens already taken from the others are spent.
+	for _, b := range buckets {
+		decision, err := l.buckets.Take(r.Context(), b.key, b.limit)
+		if err != nil {
+			logctx.Logger(r.Context(), l.logger).WithError(err).WithField("bucket", b.key).Error("failed to take rate limit token, allowing request")
+			continue
+		}
+
+		setRateLimitHeaders(w, decision)
+		if !decision.Allowed {
+			l.reject(w, r, b, decision)
+			return false
+		}
+	}
+
+	return true
+}
+
+// check writes the error response and returns false if any of the buckets has no token left,
+// without taking any.
+func (l *RateLimiter) check(w http.ResponseWriter, r *http.Request, buckets []bucket) bool {
+	for _, b := range buckets {
+		decision, err := l.buckets.Check(r.Context(), b.key, b.limit)
+		if err != nil {
+			logctx.Logger(r.Context(), l.logger).WithError(err).WithField("bucket", b.key).Error("failed to check rate limit, allowing request")
+			continue
+		}
+
+		if !decision.Allowed {
+			setRateLimitHeaders(w, decision)
+			l.reject(w, r, b, decision)
+			return false
+		}
+	}
+
+	return true
+}
+
+// take takes a token from each of the buckets of a request that has already been responded to.
+func (l *RateLimiter) take(r *http.Request, buckets []bucket) {
+	for _, b := range buckets {
+		if _, err := l.buckets.Take(r.Context(), b.key, b.limit); err != nil {
+			logctx.Logger(r.Context(), l.logger).WithError(err).WithField("bucket", b.key).Error("failed to take rate limit token")
+		}
+	}
+}
+
+// reject writes the response to a request that the bucket has no token left for.
+func (l *RateLimiter) reject(w http.ResponseWriter, r *http.Request, b bucket, decision ratelimit.Decision) {
+	retryAfter := ceilSeconds(decision.RetryAfter)
+	w.Header().Set(retryAfterHeader, strconv.Itoa(retryAfter))
+
+	msg := fmt.Sprintf("the rate limit of %s has been reached, retry after %d seconds", b.limit, retryAfter)
+	writeErrorResponse(w, r, terrors.Presentable(newTooManyRequests(msg)), l.logger)
+}
+
+// operationID returns the ID of the operation that the request was routed to, or an empty string
+// if it was not routed to one from the spec.
+func (l *RateLimiter) operationID(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+
+	path, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+
+	return l.operations[routeKey(r.Method, path)]
+}
+
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// setRateLimitHeaders describes the decision in the RateLimit headers, unless they already
+// describe a limit with fewer requests left.
+func setRateLimitHeaders(w http.ResponseWriter, decision ratelimit.Decision) {
+	if remaining, err := strconv.Atoi(w.Header().Get(rateLimitRemainingHeader)); err == nil && remaining < decision.Remaining {
+		return
+	}
+
+	w.Header().Set(rateLimitLimitHeader, strconv.Itoa(decision.Limit.Requests))
+	w.Header().Set(rateLimitRemainingHeader, strconv.Itoa(decision.Remaining))
+	w.Header().Set(rateLimitResetHeader, strconv.Itoa(ceilSeconds(decision.Reset)))
+}
+
+// ceilSeconds rounds a duration up to whole seconds, as the headers cannot say to wait for less
+// than a second.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,102 @@
+package rest_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+)
+
+// highLimit is a limit that no test reaches.
+var highLimit = ratelimit.Limit{Requests: 10000, Per: time.Minute}
+
+func TestRateLimitRejectsRequestsOverTheLimit(t *testing.T) {
+	server := newTestServerWithLimits(t, rest.RateLimits{
+		Client: highLimit,
+		User:   ratelimit.Limit{Requests: 2, Per: time.Minute},
+	})
+	req := request{method: http.MethodGet, path: "/api/v2/orders?userid=" + uuid.NewString()}
+
+	for _, remaining := range []string{"1", "0"} {
+		resp := server.expect(t, req, http.StatusOK, nil)
+		if got := resp.Header.Get("RateLimit-Limit"); got != "2" {
+			t.Errorf("RateLimit-Limit is %q, want 2", got)
+		}
+		if got := resp.Header.Get("RateLimit-Remaining"); got != remaining {
+			t.Errorf("RateLimit-Remaining is %q, want %s", got, remaining)
+		}
+	}
+
+	resp, body := server.do(t, req)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("request over the limit returned %d, want 429: %s", resp.StatusCode, body)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "application/problem+json" {
+		t.Errorf("Content-Type is %q, want application/problem+json", got)
+	}
+	if got := resp.Header.Get("RateLimit-Remaining"); got != "0" {
+		t.Errorf("RateLimit-Remaining is %q, want 0", got)
+	}
+
+	// A token is added every 30 seconds, and the bucket holds 2.
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err != nil || retryAfter < 1 || retryAfter > 30 {
+		t.Errorf("Retry-After is %q, want between 1 and 30 seconds", resp.Header.Get("Retry-After"))
+	}
+	if reset, err := strconv.Atoi(resp.Header.Get("RateLimit-Reset")); err != nil || reset < 31 || reset > 60 {
+		t.Errorf("RateLimit-Reset is %q, want between 31 and 60 seconds", resp.Header.Get("RateLimit-Reset"))
+	}
+}
+
+func TestRateLimitDoesNotSpendTokensOfRefusedRequests(t *testing.T) {
+	server := newTestServerWithLimits(t, rest.RateLimits{
+		Client:     highLimit,
+		User:       ratelimit.Limit{Requests: 2, Per: time.Minute},
+		Operations: map[string]ratelimit.Limit{"ListOrdersV2": {Requests: 1, Per: time.Minute}},
+	})
+	userID := uuid.NewString()
+	list := request{method: http.MethodGet, path: "/api/v2/orders?userid=" + userID}
+	listV1 := request{method: http.MethodGet, path: "/api/v1/orders?userid=" + userID}
+
+	server.expect(t, list, http.StatusOK, nil)
+	server.expect(t, list, http.StatusTooManyRequests, nil)
+
+	// The request refused by the operation's limit did not take the user's second token.
+	server.expect(t, listV1, http.StatusOK, nil)
+	server.expect(t, listV1, http.StatusTooManyRequests, nil)
+}
+
+func TestRateLimitByAPIKey(t *testing.T) {
+	server := newTestServerWithLimits(t, rest.RateLimits{
+		Client: highLimit,
+		APIKey: ratelimit.Limit{Requests: 1, Per: time.Minute},
+		User:   highLimit,
+	})
+	withKey := func(key string) request {
+		return request{
+			method: http.MethodGet,
+			path:   "/api/v2/orders?userid=" + uuid.NewString(),
+			header: http.Header{"X-Api-Key": {key}},
+		}
+	}
+
+	server.expect(t, withKey("key-a"), http.StatusOK, nil)
+	server.expect(t, withKey("key-a"), http.StatusTooManyRequests, nil)
+	server.expect(t, withKey("key-b"), http.StatusOK, nil)
+
+	// Requests without a key are only limited by the other limits.
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders?userid=" + uuid.NewString()}, http.StatusOK, nil)
+}
+
+func TestClientRateLimitCountsUnauthenticatedRequests(t *testing.T) {
+	server := newTestServerWithLimits(t, rest.RateLimits{
+		Client: ratelimit.Limit{Requests: 1, Per: time.Minute},
+		User:   highLimit,
+	})
+
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders"}, http.StatusUnauthorized, nil)
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders?userid=" + uuid.NewString()}, http.StatusTooManyRequests, nil)
+}
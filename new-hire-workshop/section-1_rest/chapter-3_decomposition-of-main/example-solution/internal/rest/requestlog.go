@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// requestIDPattern matches the request IDs that are accepted from clients.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestLogging gives every request an ID and a logger with the ID in the request's context.
+type RequestLogging struct {
+	logger logging.Logger
+}
+
+func NewRequestLogging(logger logging.Logger) *RequestLogging {
+	return &RequestLogging{
+		logger: logger,
+	}
+}
+
+// Middleware uses the ID in the request's X-Request-ID header if it has one, and otherwise makes
+// up a new one.
+func (l *RequestLogging) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		fields := logging.Fields{
+			"request-id": requestID,
+			"method":     r.Method,
+			"path":       r.URL.Path,
+		}
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				fields["route"] = template
+			}
+		}
+
+		ctx := logging.ContextWithLogger(r.Context(), l.logger.WithFields(fields))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
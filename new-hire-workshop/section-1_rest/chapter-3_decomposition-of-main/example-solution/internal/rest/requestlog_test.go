@@ -0,0 +1,90 @@
+package rest_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logtest"
+)
+
+func TestRequestIDHeader(t *testing.T) {
+	server := newTestServer(t)
+	path := "/api/v2/orders?userid=" + uuid.NewString()
+
+	tests := []struct {
+		name      string
+		requestID string
+		echoed    bool
+	}{
+		{name: "valid ID", requestID: "client-request.1:a", echoed: true},
+		{name: "missing ID", requestID: ""},
+		{name: "ID with spaces", requestID: "not a request id"},
+		{name: "ID that is too long", requestID: strings.Repeat("a", 129)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.requestID != "" {
+				header.Set("X-Request-ID", tt.requestID)
+			}
+
+			resp := server.expect(t, request{method: http.MethodGet, path: path, header: header}, http.StatusOK, nil)
+			got := resp.Header.Get("X-Request-ID")
+			if tt.echoed {
+				if got != tt.requestID {
+					t.Errorf("X-Request-ID is %q, want the request's %q", got, tt.requestID)
+				}
+				return
+			}
+
+			if _, err := uuid.Parse(got); err != nil {
+				t.Errorf("X-Request-ID is %q, want a generated UUID", got)
+			}
+		})
+	}
+}
+
+func TestRequestLogging(t *testing.T) {
+	logger := logtest.NewLogger()
+	server := newTestServerWithLogger(t, logger)
+	customerID := uuid.NewString()
+
+	server.expect(t, request{
+		method: http.MethodPost,
+		path:   "/api/v2/orders?userid=" + customerID,
+		body:   placeOrderBody,
+		header: http.Header{"X-Request-Id": {"place-order-1"}},
+	}, http.StatusOK, nil)
+
+	// The order store logs with the logger from the request's context, so its entry says which
+	// request and customer the order was written for.
+	var created *logtest.Entry
+	for _, entry := range logger.Entries() {
+		entry := entry
+		if entry.Message == "created order" {
+			created = &entry
+		}
+	}
+	if created == nil {
+		t.Fatalf("no entry was logged for the created order, got %+v", logger.Entries())
+	}
+
+	want := logging.Fields{
+		"request-id":          "place-order-1",
+		"method":              http.MethodPost,
+		logging.CustomerIDKey: customerID,
+		"user-tenant-id":      "default",
+	}
+	for key, value := range want {
+		if created.Fields[key] != value {
+			t.Errorf("created order entry has %s %v, want %v", key, created.Fields[key], value)
+		}
+	}
+	if _, ok := created.Fields[logging.UserIDKey]; ok {
+		t.Errorf("created order entry has a %s field, want the customer logged under %s only", logging.UserIDKey, logging.CustomerIDKey)
+	}
+}
@@ -0,0 +1,25 @@
+// Package resttest provides helpers for testing the REST API, in the style of net/http/httptest.
+package resttest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+)
+
+// CheckResponses wraps a handler so that every response it writes is checked against the spec,
+// failing the test for each one that does not match.
+func CheckResponses(t testing.TB, spec *openapi3.T, handler http.Handler) http.Handler {
+	t.Helper()
+
+	validator, err := rest.NewResponseValidator(spec, func(r *http.Request, err error) {
+		t.Errorf("response to %s %s does not match the API spec: %s", r.Method, r.URL, err)
+	})
+	if err != nil {
+		t.Fatalf("failed to create response validator: %s", err)
+	}
+
+	return validator.Middleware(handler)
+}
@@ -0,0 +1,55 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+func TestStaffRoles(t *testing.T) {
+	server, issuer := newTokenTestServer(t)
+	customerID := uuid.NewString()
+	customer := issuer.header(issuer.claims("tenant", customerID))
+	otherCustomer := issuer.header(issuer.claims("tenant", uuid.NewString()))
+	support := issuer.header(issuer.claims("tenant", "support@example.com", withClaim("roles", []string{"support"})))
+	admin := issuer.header(issuer.claims("tenant", "admin@example.com", withClaim("roles", []string{"admin"})))
+	otherTenantAdmin := issuer.header(issuer.claims("other-tenant", "admin@example.com", withClaim("roles", []string{"admin"})))
+
+	var placed api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders", body: placeOrderBody, header: customer}, http.StatusOK, &placed)
+
+	orderPath := fmt.Sprintf("/api/v2/orders/%s", placed.OrderId)
+	setStatusPath := orderPath + ":setStatus"
+	inTransit := fmt.Sprintf(`{"status": %q}`, api.OrderStatusV2InTransit)
+
+	tests := []struct {
+		name   string
+		req    request
+		status int
+	}{
+		{name: "support reads a customer's order", req: request{method: http.MethodGet, path: orderPath, header: support}, status: http.StatusOK},
+		{name: "support lists a customer's orders", req: request{method: http.MethodGet, path: "/api/v2/orders?customerId=" + customerID, header: support}, status: http.StatusOK},
+		{name: "customer cannot hold an order", req: request{method: http.MethodPost, path: orderPath + ":hold", header: customer}, status: http.StatusForbidden},
+		{name: "customer cannot set an order's status", req: request{method: http.MethodPost, path: setStatusPath, body: inTransit, header: customer}, status: http.StatusForbidden},
+		{name: "other customer cannot read the order", req: request{method: http.MethodGet, path: orderPath, header: otherCustomer}, status: http.StatusNotFound},
+		{name: "support cannot set an order's status", req: request{method: http.MethodPost, path: setStatusPath, body: inTransit, header: support}, status: http.StatusForbidden},
+		{name: "admin in another tenant cannot set the order's status", req: request{method: http.MethodPost, path: setStatusPath, body: inTransit, header: otherTenantAdmin}, status: http.StatusNotFound},
+		{name: "support holds a customer's order", req: request{method: http.MethodPost, path: orderPath + ":hold", body: `{"reason": "checking the address"}`, header: support}, status: http.StatusOK},
+		{name: "admin sets an order's status", req: request{method: http.MethodPost, path: setStatusPath, body: inTransit, header: admin}, status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.expect(t, tt.req, tt.status, nil)
+		})
+	}
+
+	var order api.OrderV2
+	server.expect(t, request{method: http.MethodGet, path: orderPath, header: customer}, http.StatusOK, &order)
+	if order.Status != api.OrderStatusV2InTransit {
+		t.Errorf("order is %s, want the status set by the admin", order.Status)
+	}
+}
@@ -2,15 +2,21 @@ package rest
 
 import (
 	"context"
-	"ecommerce-workshop/internal/orders"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
 	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
 )
 
 type Server struct {
@@ -18,6 +24,9 @@ type Server struct {
 	logger logging.Logger
 }
 
+// stoppingKey is the context key for the channel that is closed when the server starts to stop.
+type stoppingKey struct{}
+
 // The baseCtx that is specified here is used when creating a context for all requests that this server
 // creates. This allows us to cancel all currently handled requests from where we are invoking the server,
 // should we wish to.
@@ -34,18 +43,35 @@ func NewServer(baseCtx context.Context, port int, router *mux.Router, logger log
 		return nil, errors.New("baseCtx is nil")
 	}
 
-	return &Server{
-		server: &http.Server{
-			Handler: router,
-			Addr:    fmt.Sprintf(":%d", port),
-			BaseContext: func(net.Listener) context.Context {
-				return baseCtx
-			},
+	// Shutdown waits for every request to finish, which a request for an event stream never does
+	// on its own. The streams are told to finish instead, through a channel that is closed as soon
+	// as the shutdown starts.
+	stopping := make(chan struct{})
+	var stopOnce sync.Once
+
+	server := &http.Server{
+		Handler: router,
+		Addr:    fmt.Sprintf(":%d", port),
+		BaseContext: func(net.Listener) context.Context {
+			return context.WithValue(baseCtx, stoppingKey{}, stopping)
 		},
+	}
+	server.RegisterOnShutdown(func() {
+		stopOnce.Do(func() { close(stopping) })
+	})
+
+	return &Server{
+		server: server,
 		logger: logger,
 	}, nil
 }
 
+// serverStopping returns a channel that is closed once the server handling the request stops.
+func serverStopping(ctx context.Context) <-chan struct{} {
+	stopping, _ := ctx.Value(stoppingKey{}).(chan struct{})
+	return stopping
+}
+
 func (s *Server) Start() {
 	// We log out the address that the server is using for visibility.
 	s.logger.WithField("address", s.server.Addr).Info("serving REST")
@@ -70,10 +96,68 @@ func (s *Server) Stop() error {
 	return s.server.Shutdown(shutDownCtx)
 }
 
-func NewMux(orderStore *orders.OrderStore, logger logging.Logger) *mux.Router {
-	listHandler := NewListOrderSummariesHandler(orderStore, logger)
-
+// NewMux routes every operation in the API spec to the handlers, and serves the docs.
+func NewMux(
+	orderStore orders.OrderRepository,
+	orderService *orders.Service,
+	feed *orders.Feed,
+	webhookService *webhooks.Service,
+	policy *authz.Policy,
+	validator *RequestValidator,
+	rateLimiter *RateLimiter,
+	authenticator *Authenticator,
+	idempotency *Idempotency,
+	docs *Docs,
+	logger logging.Logger,
+	middlewares ...mux.MiddlewareFunc,
+) *mux.Router {
 	router := mux.NewRouter()
-	router.Handle("api/v1/orders", listHandler).Methods(http.MethodGet)
+	router.Use(NewRequestLogging(logger).Middleware)
+	docs.register(router)
+
+	// Requests are authenticated before they are validated, so that no work is done for callers
+	// who cannot say who they are. Rate limits are checked either side of authentication, so
+	// that clients are limited by address until it is known which user they are. Idempotency
+	// keys are only handled once the request is known to be valid, so that a request that is
+	// rejected does not use up its key.
+	apiRouter := router.NewRoute().Subrouter()
+	apiRouter.Use(middlewares...)
+	apiRouter.Use(rateLimiter.ClientMiddleware)
+	apiRouter.Use(authenticator.Middleware)
+	apiRouter.Use(rateLimiter.Middleware)
+	apiRouter.Use(validator.Middleware)
+	apiRouter.Use(idempotency.Middleware)
+
+	writeError := func(w http.ResponseWriter, r *http.Request, err error) {
+		writeErrorResponse(w, r, err, logger)
+	}
+
+	handler := api.NewStrictHandlerWithOptions(NewHandlers(orderStore, orderService, feed, webhookService, policy, logger), nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, r, newInvalidBody(err))
+		},
+		ResponseErrorHandlerFunc: writeError,
+	})
+
+	api.HandlerWithOptions(handler, api.GorillaServerOptions{
+		BaseRouter:  apiRouter,
+		Middlewares: []api.MiddlewareFunc{emptyBodyAsEmptyObject},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, r, newInvalidParam(err))
+		},
+	})
+
 	return router
 }
+
+// emptyBodyAsEmptyObject gives requests without a body an empty JSON object, so that optional
+// bodies can be left out.
+func emptyBodyAsEmptyObject(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength == 0 {
+			r.Body = io.NopCloser(strings.NewReader("{}"))
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,217 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/resttest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+)
+
+// testServer is the API with in-memory stores behind it. Every response it sends is checked
+// against the API spec, failing the test if it does not match.
+type testServer struct {
+	*httptest.Server
+	// router serves the API without checking its responses, for the routes that are not in the spec.
+	router *mux.Router
+	orders *orders.OrderStore
+	feed   *orders.Feed
+}
+
+// newTestServer starts a server that takes the user from the userid query parameter, as a
+// development server does. It is closed when the test ends.
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	return newTestServerWithAuthenticator(t, rest.NewQueryParamAuthenticator(logging.NewNoopLogger()))
+}
+
+func newTestServerWithAuthenticator(t *testing.T, authenticator *rest.Authenticator) *testServer {
+	t.Helper()
+
+	// The limits are high enough that no test reaches them by accident.
+	limit := ratelimit.Limit{Requests: 10000, Per: time.Minute}
+	return startTestServer(t, authenticator, rest.RateLimits{Client: limit, User: limit}, logging.NewNoopLogger())
+}
+
+// newTestServerWithLimits starts a server that takes the user from the userid query parameter,
+// with the rate limits given.
+func newTestServerWithLimits(t *testing.T, limits rest.RateLimits) *testServer {
+	t.Helper()
+
+	return startTestServer(t, rest.NewQueryParamAuthenticator(logging.NewNoopLogger()), limits, logging.NewNoopLogger())
+}
+
+// newTestServerWithLogger starts a server that takes the user from the userid query parameter,
+// and logs with the logger.
+func newTestServerWithLogger(t *testing.T, logger logging.Logger) *testServer {
+	t.Helper()
+
+	limit := ratelimit.Limit{Requests: 10000, Per: time.Minute}
+	return startTestServer(t, rest.NewQueryParamAuthenticator(logger), rest.RateLimits{Client: limit, User: limit}, logger)
+}
+
+func startTestServer(t *testing.T, authenticator *rest.Authenticator, limits rest.RateLimits, logger logging.Logger) *testServer {
+	t.Helper()
+
+	ctx := context.Background()
+
+	spec, err := rest.ParseSpec(ctx, api.Spec)
+	if err != nil {
+		t.Fatalf("parsing API spec: %v", err)
+	}
+
+	validator, err := rest.NewRequestValidator(spec, logger)
+	if err != nil {
+		t.Fatalf("creating request validator: %v", err)
+	}
+
+	docs, err := rest.NewDocs(api.Spec, spec, logger)
+	if err != nil {
+		t.Fatalf("creating docs: %v", err)
+	}
+
+	rateLimiter, err := rest.NewRateLimiter(ratelimit.NewBucketStore(), limits, spec, logger)
+	if err != nil {
+		t.Fatalf("creating rate limiter: %v", err)
+	}
+
+	store := orders.NewOrderStore()
+	feed := orders.NewFeed()
+	t.Cleanup(feed.Close)
+	orderStore := orders.NewFeedRepository(store, feed)
+
+	webhookService := webhooks.NewService(webhooks.NewStore(), rest.EncodeWebhookEvent, webhooks.RetryPolicy{
+		MaxAttempts: 1,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Second,
+	}, logger)
+	orderService := orders.NewService(orderStore, webhookService, logger)
+	idempotencyKeys := rest.NewIdempotency(idempotency.NewKeyStore(), time.Hour, logger)
+	policy := authz.NewPolicy(authz.NewLogAuditLog(logger))
+
+	router := rest.NewMux(orderStore, orderService, feed, webhookService, policy, validator, rateLimiter, authenticator, idempotencyKeys, docs, logger)
+	server := httptest.NewServer(resttest.CheckResponses(t, spec, router))
+	t.Cleanup(server.Close)
+
+	return &testServer{
+		Server: server,
+		router: router,
+		orders: store,
+		feed:   feed,
+	}
+}
+
+// request is a request to the test server. The body is sent as JSON if it is not empty.
+type request struct {
+	method string
+	path   string
+	body   string
+	header http.Header
+}
+
+// do sends the request, failing the test if it cannot be sent. The response body is read and
+// closed, and returned along with the response.
+func (s *testServer) do(t *testing.T, req request) (*http.Response, []byte) {
+	t.Helper()
+
+	var body io.Reader
+	if req.body != "" {
+		body = strings.NewReader(req.body)
+	}
+
+	httpReq, err := http.NewRequest(req.method, s.URL+req.path, body)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+
+	for name, values := range req.header {
+		httpReq.Header[name] = values
+	}
+	if req.body != "" {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.Client().Do(httpReq)
+	if err != nil {
+		t.Fatalf("sending %s %s: %v", req.method, req.path, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response to %s %s: %v", req.method, req.path, err)
+	}
+
+	return resp, respBody
+}
+
+// expect sends the request and fails the test if the response does not have the status. The body
+// of the response is decoded into out, if it is not nil.
+func (s *testServer) expect(t *testing.T, req request, status int, out interface{}) *http.Response {
+	t.Helper()
+
+	resp, body := s.do(t, req)
+	if resp.StatusCode != status {
+		t.Fatalf("%s %s returned %d, want %d: %s", req.method, req.path, resp.StatusCode, status, body)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			t.Fatalf("decoding response to %s %s: %v", req.method, req.path, err)
+		}
+	}
+
+	return resp
+}
+
+func TestDocs(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		path        string
+		contentType string
+	}{
+		{path: "/api/v1/openapi.yaml", contentType: "application/yaml"},
+		{path: "/api/v1/openapi.json", contentType: "application/json"},
+		{path: "/api/docs", contentType: "text/html; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			server.router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			resp, body := recorder.Result(), recorder.Body.Bytes()
+			if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != tt.contentType {
+				t.Fatalf("GET %s returned %d with content type %q, want a 200 with %q", tt.path, resp.StatusCode, resp.Header.Get("Content-Type"), tt.contentType)
+			}
+
+			if tt.path == "/api/docs" {
+				return
+			}
+
+			spec, err := openapi3.NewLoader().LoadFromData(body)
+			if err != nil {
+				t.Fatalf("parsing spec served at %s: %v", tt.path, err)
+			}
+			if err := spec.Validate(context.Background()); err != nil {
+				t.Errorf("spec served at %s is not valid: %v", tt.path, err)
+			}
+		})
+	}
+}
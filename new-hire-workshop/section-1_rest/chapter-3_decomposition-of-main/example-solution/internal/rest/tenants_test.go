@@ -0,0 +1,69 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+// TestTenantsCannotReachEachOthersResources uses a customer ID that exists in two tenants, so
+// only the tenant keeps them apart.
+func TestTenantsCannotReachEachOthersResources(t *testing.T) {
+	server, issuer := newTokenTestServer(t)
+	customerID := uuid.NewString()
+	tokenA := issuer.header(issuer.claims("tenant-a", customerID))
+	tokenB := issuer.header(issuer.claims("tenant-b", customerID))
+
+	var order api.OrderSummaryV2
+	server.expect(t, request{method: http.MethodPost, path: "/api/v2/orders", body: placeOrderBody, header: tokenA}, http.StatusOK, &order)
+
+	var webhook api.CreatedWebhook
+	server.expect(t, request{method: http.MethodPost, path: "/api/v1/webhooks", body: `{"url": "https://hooks.example.com/orders"}`, header: tokenA}, http.StatusCreated, &webhook)
+
+	tests := []struct {
+		name string
+		req  request
+	}{
+		{name: "get order", req: request{method: http.MethodGet, path: fmt.Sprintf("/api/v2/orders/%s", order.OrderId)}},
+		{name: "get order in version 1", req: request{method: http.MethodGet, path: fmt.Sprintf("/api/v1/orders/%s", order.OrderId)}},
+		{name: "cancel order", req: request{method: http.MethodPost, path: fmt.Sprintf("/api/v2/orders/%s:cancel", order.OrderId)}},
+		{name: "order events", req: request{method: http.MethodGet, path: fmt.Sprintf("/api/v1/orders/%s/events", order.OrderId)}},
+		{name: "delete webhook", req: request{method: http.MethodDelete, path: fmt.Sprintf("/api/v1/webhooks/%s", webhook.Id)}},
+		{name: "webhook deliveries", req: request{method: http.MethodGet, path: fmt.Sprintf("/api/v1/webhooks/%s/deliveries", webhook.Id)}},
+		{name: "replay webhook", req: request{method: http.MethodPost, path: fmt.Sprintf("/api/v1/webhooks/%s:replay", webhook.Id)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.header = tokenB
+			server.expect(t, tt.req, http.StatusNotFound, nil)
+		})
+	}
+
+	var orders api.OrderSummaryListV2
+	server.expect(t, request{method: http.MethodGet, path: "/api/v2/orders", header: tokenB}, http.StatusOK, &orders)
+	if len(orders.OrderSummaries) != 0 {
+		t.Errorf("other tenant listed orders %+v, want none", orders.OrderSummaries)
+	}
+
+	var webhooks api.WebhookList
+	server.expect(t, request{method: http.MethodGet, path: "/api/v1/webhooks", header: tokenB}, http.StatusOK, &webhooks)
+	if len(webhooks.Webhooks) != 0 {
+		t.Errorf("other tenant listed webhooks %+v, want none", webhooks.Webhooks)
+	}
+
+	// Nothing the other tenant tried changed the order or the webhook.
+	var got api.OrderV2
+	server.expect(t, request{method: http.MethodGet, path: fmt.Sprintf("/api/v2/orders/%s", order.OrderId), header: tokenA}, http.StatusOK, &got)
+	if got.Status != api.OrderStatusV2Placed {
+		t.Errorf("order is %s, want it still %s", got.Status, api.OrderStatusV2Placed)
+	}
+
+	server.expect(t, request{method: http.MethodGet, path: "/api/v1/webhooks", header: tokenA}, http.StatusOK, &webhooks)
+	if len(webhooks.Webhooks) != 1 || webhooks.Webhooks[0].Id != webhook.Id {
+		t.Errorf("listed webhooks %+v, want the webhook to still be there", webhooks.Webhooks)
+	}
+}
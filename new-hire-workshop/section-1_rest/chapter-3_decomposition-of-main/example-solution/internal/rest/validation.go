@@ -0,0 +1,179 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/logctx"
+)
+
+func init() {
+	// The spec uses the uuid format for every ID, which the OpenAPI library only checks if asked.
+	openapi3.DefineStringFormat("uuid", openapi3.FormatOfStringForUUIDOfRFC4122)
+}
+
+// LoadSpec reads the OpenAPI spec for the API from a file, checking that the spec itself is valid.
+func LoadSpec(ctx context.Context, path string) (*openapi3.T, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading API spec %s: %w", path, err)
+	}
+
+	spec, err := ParseSpec(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("loading API spec %s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+// ParseSpec parses an OpenAPI spec for the API, such as the api.Spec built into the server,
+// checking that the spec itself is valid.
+func ParseSpec(ctx context.Context, data []byte) (*openapi3.T, error) {
+	spec, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing API spec: %w", err)
+	}
+
+	if err := spec.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("validating API spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+// RequestValidator checks incoming requests against the OpenAPI spec.
+type RequestValidator struct {
+	router routers.Router
+	logger logging.Logger
+}
+
+func NewRequestValidator(spec *openapi3.T, logger logging.Logger) (*RequestValidator, error) {
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("building router from API spec: %w", err)
+	}
+
+	return &RequestValidator{
+		router: router,
+		logger: logger,
+	}, nil
+}
+
+// Middleware rejects any request that does not match the spec with a 400.
+func (v *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			logctx.Logger(r.Context(), v.logger).WithError(err).Debug("request does not match an operation in the API spec")
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			invalidErr := terrors.NewInvalidInput(invalidInputsFromError(err, nil), err)
+			writeErrorResponse(w, r, terrors.Presentable(invalidErr), v.logger)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// invalidInputsFromError flattens the errors from validating a request into the inputs they are
+// about, appending them to inputs.
+func invalidInputsFromError(err error, inputs []terrors.InputAndMsg) []terrors.InputAndMsg {
+	// A request error can wrap a multi error of its own, so only a multi error at the top level is
+	// a list of request errors.
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		for _, err := range multiErr {
+			inputs = invalidInputsFromError(err, inputs)
+		}
+		return inputs
+	}
+
+	requestErr := &openapi3filter.RequestError{}
+	if !errors.As(err, &requestErr) {
+		return append(inputs, terrors.InputAndMsg{Input: "request", Msg: err.Error()})
+	}
+
+	if requestErr.Parameter != nil {
+		return append(inputs, terrors.InputAndMsg{
+			Input: requestErr.Parameter.Name,
+			Msg:   requestErrorMsg(requestErr),
+		})
+	}
+
+	// A request body that does not match its schema has an error for each field that is wrong.
+	var schemaErrs openapi3.MultiError
+	if !errors.As(requestErr.Err, &schemaErrs) {
+		schemaErrs = openapi3.MultiError{requestErr.Err}
+	}
+
+	for _, err := range schemaErrs {
+		schemaErr := &openapi3.SchemaError{}
+		if err == nil || !errors.As(err, &schemaErr) {
+			inputs = append(inputs, terrors.InputAndMsg{Input: "request body", Msg: requestErrorMsg(requestErr)})
+			continue
+		}
+
+		input := "request body"
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			input = strings.Join(pointer, ".")
+		}
+		inputs = append(inputs, terrors.InputAndMsg{Input: input, Msg: schemaErrorMsg(schemaErr)})
+	}
+
+	return inputs
+}
+
+func requestErrorMsg(err *openapi3filter.RequestError) string {
+	schemaErr := &openapi3.SchemaError{}
+	switch {
+	case errors.Is(err.Err, openapi3filter.ErrInvalidRequired):
+		return "is required"
+	case errors.Is(err.Err, openapi3filter.ErrInvalidEmptyValue):
+		return "must not be empty"
+	case errors.As(err.Err, &schemaErr):
+		return schemaErrorMsg(schemaErr)
+	case err.Err != nil && err.Reason != "":
+		return fmt.Sprintf("%s: %s", err.Reason, err.Err)
+	case err.Err != nil:
+		return err.Err.Error()
+	default:
+		return err.Reason
+	}
+}
+
+// schemaErrorMsg describes a value that failed schema validation without the library's dump of
+// the schema and the value.
+func schemaErrorMsg(err *openapi3.SchemaError) string {
+	switch err.SchemaField {
+	case "required":
+		return "is required"
+	case "format":
+		return fmt.Sprintf("must be a valid %s", err.Schema.Format)
+	default:
+		return err.Reason
+	}
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+)
+
+// APIVersion identifies a version of the REST API. Every version is served by the same handlers,
+// and they differ only in how orders are represented in the responses.
+type APIVersion int
+
+const (
+	// APIVersion1 only reports the placed, in transit and delivered statuses, with cancelled and
+	// held orders shown as placed.
+	APIVersion1 APIVersion = 1
+	// APIVersion2 reports every order status, along with the reason for it.
+	APIVersion2 APIVersion = 2
+)
+
+// APIVersions lists every version of the API that is served.
+var APIVersions = []APIVersion{APIVersion1, APIVersion2}
+
+// requestVersion returns the version of the API that a request was made to, from the version in
+// its path prefix. Requests outside of the API are treated as version 1.
+func requestVersion(r *http.Request) APIVersion {
+	for _, version := range APIVersions {
+		prefix := fmt.Sprintf("/api/v%d/", version)
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return version
+		}
+	}
+
+	return APIVersion1
+}
+
+// statusFilter converts a status given in a list filter into the order statuses it covers.
+func (v APIVersion) statusFilter(status string) ([]orders.OrderStatus, bool) {
+	if v == APIVersion2 {
+		switch api.OrderStatusV2(status) {
+		case api.OrderStatusV2Placed:
+			return []orders.OrderStatus{orders.OrderStatusPlaced}, true
+		case api.OrderStatusV2InTransit:
+			return []orders.OrderStatus{orders.OrderStatusTransit}, true
+		case api.OrderStatusV2Delivered:
+			return []orders.OrderStatus{orders.OrderStatusDelivered}, true
+		case api.OrderStatusV2Cancelled:
+			return []orders.OrderStatus{orders.OrderStatusCancelled}, true
+		case api.OrderStatusV2OnHold:
+			return []orders.OrderStatus{orders.OrderStatusOnHold}, true
+		}
+
+		return nil, false
+	}
+
+	switch api.OrderStatus(status) {
+	case api.OrderStatusPlaced:
+		return []orders.OrderStatus{orders.OrderStatusPlaced, orders.OrderStatusCancelled, orders.OrderStatusOnHold}, true
+	case api.OrderStatusInTransit:
+		return []orders.OrderStatus{orders.OrderStatusTransit}, true
+	case api.OrderStatusDelivered:
+		return []orders.OrderStatus{orders.OrderStatusDelivered}, true
+	}
+
+	return nil, false
+}
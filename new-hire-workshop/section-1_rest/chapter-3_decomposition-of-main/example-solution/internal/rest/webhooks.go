@@ -0,0 +1,264 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+)
+
+func (h *Handlers) ListWebhooks(ctx context.Context, request api.ListWebhooksRequestObject) (api.ListWebhooksResponseObject, error) {
+	owner, err := h.webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := h.webhookService.Subscriptions(ctx, owner.TenantID, owner.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	list := api.WebhookList{Webhooks: make([]api.Webhook, 0, len(subs))}
+	for _, sub := range subs {
+		webhook, err := internalSubscriptionToREST(sub)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Webhooks = append(list.Webhooks, webhook)
+	}
+
+	return api.ListWebhooks200JSONResponse(list), nil
+}
+
+func (h *Handlers) CreateWebhook(ctx context.Context, request api.CreateWebhookRequestObject) (api.CreateWebhookResponseObject, error) {
+	owner, err := h.webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCreateWebhookRequest(*request.Body); err != nil {
+		return nil, err
+	}
+
+	sub, err := h.webhookService.Subscribe(ctx, owner.TenantID, owner.ID, request.Body.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := internalSubscriptionToREST(sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.CreateWebhook201JSONResponse{
+		Id:        webhook.Id,
+		Url:       webhook.Url,
+		CreatedAt: webhook.CreatedAt,
+		Secret:    sub.Secret,
+	}, nil
+}
+
+func (h *Handlers) DeleteWebhook(ctx context.Context, request api.DeleteWebhookRequestObject) (api.DeleteWebhookResponseObject, error) {
+	owner, err := h.webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.webhookService.Unsubscribe(ctx, owner.TenantID, owner.ID, request.Webhookid.String()); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteWebhook204Response{}, nil
+}
+
+func (h *Handlers) ListWebhookDeliveries(ctx context.Context, request api.ListWebhookDeliveriesRequestObject) (api.ListWebhookDeliveriesResponseObject, error) {
+	owner, err := h.webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := h.webhookService.Deliveries(ctx, owner.TenantID, owner.ID, request.Webhookid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := internalDeliveriesToREST(deliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ListWebhookDeliveries200JSONResponse(list), nil
+}
+
+func (h *Handlers) ReplayWebhookDeliveries(ctx context.Context, request api.ReplayWebhookDeliveriesRequestObject) (api.ReplayWebhookDeliveriesResponseObject, error) {
+	owner, err := h.webhookOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := h.webhookService.Replay(ctx, owner.TenantID, owner.ID, request.Webhookid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := internalDeliveriesToREST(deliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ReplayWebhookDeliveries200JSONResponse(list), nil
+}
+
+// webhookOwner returns the user that the request is from, who is always the customer whose webhooks
+// the request is for, as staff cannot manage customers' webhooks.
+func (h *Handlers) webhookOwner(ctx context.Context) (authz.Principal, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return authz.Principal{}, err
+	}
+
+	resource := authz.Resource{TenantID: principal.TenantID, CustomerID: principal.ID}
+	if err := h.policy.Authorize(ctx, principal, authz.ActionManageWebhooks, resource); err != nil {
+		return authz.Principal{}, err
+	}
+
+	return principal, nil
+}
+
+// validateCreateWebhookRequest only allows absolute http and https URLs, as the spec's uri format
+// also allows URLs that events cannot be sent to, such as mailto: ones.
+func validateCreateWebhookRequest(req api.CreateWebhookRequest) error {
+	parsed, err := url.Parse(req.Url)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return terrors.Presentable(terrors.NewInvalidSingleInput("url", "must be an absolute http or https URL", err))
+	}
+
+	return nil
+}
+
+// EncodeWebhookEvent encodes an order event as a WebhookEvent from the API spec. It is the
+// webhooks.Encoder for the deliveries sent to webhooks registered through the API.
+func EncodeWebhookEvent(eventID string, event orders.Event) ([]byte, error) {
+	status, err := orderStatusToRESTV2(event.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	body := api.WebhookEvent{
+		Type:       api.WebhookEventType(event.Type),
+		OccurredAt: event.OccurredAt,
+		Status:     status,
+		Reason:     optionalString(event.Reason),
+	}
+
+	if body.Id, err = uuid.Parse(eventID); err != nil {
+		return nil, fmt.Errorf("event has an invalid ID %q: %w", eventID, err)
+	}
+
+	if body.OrderId, err = uuid.Parse(event.OrderID); err != nil {
+		return nil, fmt.Errorf("event has an invalid order ID %q: %w", event.OrderID, err)
+	}
+
+	if body.CustomerId, err = uuid.Parse(event.CustomerID); err != nil {
+		return nil, fmt.Errorf("event for order %s has an invalid customer ID %q: %w", event.OrderID, event.CustomerID, err)
+	}
+
+	return json.Marshal(body)
+}
+
+func internalSubscriptionToREST(sub webhooks.Subscription) (api.Webhook, error) {
+	id, err := uuid.Parse(sub.ID)
+	if err != nil {
+		return api.Webhook{}, fmt.Errorf("webhook has an invalid ID %q: %w", sub.ID, err)
+	}
+
+	return api.Webhook{
+		Id:        id,
+		Url:       sub.URL,
+		CreatedAt: sub.CreatedAt,
+	}, nil
+}
+
+func internalDeliveriesToREST(deliveries []webhooks.Delivery) (api.WebhookDeliveryList, error) {
+	list := api.WebhookDeliveryList{Deliveries: make([]api.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		restDelivery, err := internalDeliveryToREST(delivery)
+		if err != nil {
+			return api.WebhookDeliveryList{}, err
+		}
+
+		list.Deliveries = append(list.Deliveries, restDelivery)
+	}
+
+	return list, nil
+}
+
+func internalDeliveryToREST(delivery webhooks.Delivery) (api.WebhookDelivery, error) {
+	id, err := uuid.Parse(delivery.ID)
+	if err != nil {
+		return api.WebhookDelivery{}, fmt.Errorf("webhook delivery has an invalid ID %q: %w", delivery.ID, err)
+	}
+
+	eventID, err := uuid.Parse(delivery.EventID)
+	if err != nil {
+		return api.WebhookDelivery{}, fmt.Errorf("webhook delivery %s has an invalid event ID %q: %w", delivery.ID, delivery.EventID, err)
+	}
+
+	status, err := deliveryStatusToREST(delivery.Status)
+	if err != nil {
+		return api.WebhookDelivery{}, err
+	}
+
+	restDelivery := api.WebhookDelivery{
+		Id:        id,
+		EventId:   eventID,
+		EventType: api.WebhookEventType(delivery.EventType),
+		Status:    status,
+		Attempts:  delivery.Attempts,
+		LastError: optionalString(delivery.LastError),
+		CreatedAt: delivery.CreatedAt,
+		UpdatedAt: delivery.UpdatedAt,
+	}
+
+	// The next attempt is only meaningful while the delivery is pending, and the status code is
+	// only known if an attempt got a response.
+	if delivery.Status == webhooks.DeliveryStatusPending {
+		restDelivery.NextAttemptAt = optionalTime(delivery.NextAttemptAt)
+	}
+
+	if delivery.LastStatusCode != 0 {
+		restDelivery.LastStatusCode = &delivery.LastStatusCode
+	}
+
+	return restDelivery, nil
+}
+
+func deliveryStatusToREST(status webhooks.DeliveryStatus) (api.WebhookDeliveryStatus, error) {
+	switch status {
+	case webhooks.DeliveryStatusPending:
+		return api.WebhookDeliveryStatusPending, nil
+	case webhooks.DeliveryStatusSucceeded:
+		return api.WebhookDeliveryStatusSucceeded, nil
+	case webhooks.DeliveryStatusDead:
+		return api.WebhookDeliveryStatusDead, nil
+	}
+
+	return api.WebhookDeliveryStatus(""), fmt.Errorf("Unexpected webhook delivery status value: %s", status)
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
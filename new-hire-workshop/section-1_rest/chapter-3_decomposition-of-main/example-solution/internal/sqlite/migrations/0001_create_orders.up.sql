@@ -0,0 +1,20 @@
+CREATE TABLE orders (
+    order_id     TEXT PRIMARY KEY,
+    customer_id  TEXT NOT NULL,
+    address      TEXT NOT NULL,
+    product_id   TEXT NOT NULL,
+    payment_id   TEXT NOT NULL,
+    status       TEXT NOT NULL,
+    ordered_at   TIMESTAMP NOT NULL,
+    delivered_at TIMESTAMP NULL
+);
+
+CREATE INDEX orders_customer_id_idx ON orders (customer_id);
+
+CREATE TABLE delivery_entries (
+    order_id    TEXT NOT NULL REFERENCES orders (order_id) ON DELETE CASCADE,
+    seq         INTEGER NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    message     TEXT NOT NULL,
+    PRIMARY KEY (order_id, seq)
+);
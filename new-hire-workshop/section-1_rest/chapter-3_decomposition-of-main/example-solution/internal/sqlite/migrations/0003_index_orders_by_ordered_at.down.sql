@@ -0,0 +1,3 @@
+CREATE INDEX orders_customer_id_idx ON orders (customer_id);
+
+DROP INDEX orders_customer_ordered_at_idx;
@@ -0,0 +1,54 @@
+-- Without the tenant columns, the data of other tenants would be mixed in with the default
+-- tenant's, so the migration refuses to run while there is any rather than merging or deleting it.
+-- It has to be moved out of the database first. SQLite can only raise an error from a trigger, so
+-- the check is made by a trigger on a table that only exists for the check.
+CREATE TEMP TABLE tenants_check (other_tenant_rows INTEGER NOT NULL);
+
+CREATE TEMP TRIGGER tenants_check_refuse BEFORE INSERT ON tenants_check
+WHEN NEW.other_tenant_rows > 0
+BEGIN
+    SELECT RAISE(ABORT, 'cannot remove tenants while there is data in tenants other than default');
+END;
+
+INSERT INTO tenants_check
+    SELECT (SELECT COUNT(*) FROM orders WHERE tenant_id <> 'default')
+        + (SELECT COUNT(*) FROM webhook_subscriptions WHERE tenant_id <> 'default')
+        + (SELECT COUNT(*) FROM webhook_deliveries WHERE tenant_id <> 'default')
+        + (SELECT COUNT(*) FROM idempotency_keys WHERE tenant_id <> 'default');
+
+DROP TABLE tenants_check;
+
+CREATE TABLE idempotency_keys_old (
+    customer_id     TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    fingerprint     TEXT NOT NULL,
+    expires_at      TIMESTAMP NOT NULL,
+    status_code     INTEGER NULL,
+    content_type    TEXT NULL,
+    body            BLOB NULL,
+    PRIMARY KEY (customer_id, idempotency_key)
+);
+
+INSERT INTO idempotency_keys_old
+    SELECT customer_id, idempotency_key, fingerprint, expires_at, status_code, content_type, body
+    FROM idempotency_keys;
+
+DROP TABLE idempotency_keys;
+
+ALTER TABLE idempotency_keys_old RENAME TO idempotency_keys;
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+ALTER TABLE webhook_deliveries DROP COLUMN tenant_id;
+
+CREATE INDEX webhook_subscriptions_customer_id_idx ON webhook_subscriptions (customer_id);
+
+DROP INDEX webhook_subscriptions_tenant_customer_idx;
+
+ALTER TABLE webhook_subscriptions DROP COLUMN tenant_id;
+
+CREATE INDEX orders_customer_ordered_at_idx ON orders (customer_id, ordered_at, order_id);
+
+DROP INDEX orders_tenant_customer_ordered_at_idx;
+
+ALTER TABLE orders DROP COLUMN tenant_id;
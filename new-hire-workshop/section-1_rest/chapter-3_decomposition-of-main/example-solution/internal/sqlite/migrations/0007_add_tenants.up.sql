@@ -0,0 +1,36 @@
+ALTER TABLE orders ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX orders_tenant_customer_ordered_at_idx ON orders (tenant_id, customer_id, ordered_at, order_id);
+
+DROP INDEX orders_customer_ordered_at_idx;
+
+ALTER TABLE webhook_subscriptions ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX webhook_subscriptions_tenant_customer_idx ON webhook_subscriptions (tenant_id, customer_id);
+
+DROP INDEX webhook_subscriptions_customer_id_idx;
+
+ALTER TABLE webhook_deliveries ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+-- SQLite cannot change a table's primary key, so the table is rebuilt with the tenant in it.
+CREATE TABLE idempotency_keys_new (
+    tenant_id       TEXT NOT NULL,
+    customer_id     TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    fingerprint     TEXT NOT NULL,
+    expires_at      TIMESTAMP NOT NULL,
+    status_code     INTEGER NULL,
+    content_type    TEXT NULL,
+    body            BLOB NULL,
+    PRIMARY KEY (tenant_id, customer_id, idempotency_key)
+);
+
+INSERT INTO idempotency_keys_new
+    SELECT 'default', customer_id, idempotency_key, fingerprint, expires_at, status_code, content_type, body
+    FROM idempotency_keys;
+
+DROP TABLE idempotency_keys;
+
+ALTER TABLE idempotency_keys_new RENAME TO idempotency_keys;
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,8 @@
+CREATE TABLE rate_limit_buckets (
+    bucket_key TEXT PRIMARY KEY,
+    tokens     REAL NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    full_at    TIMESTAMP NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
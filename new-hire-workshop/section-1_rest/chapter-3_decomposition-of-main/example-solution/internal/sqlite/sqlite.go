@@ -0,0 +1,67 @@
+// Package sqlite provides an embedded, single-file database for local development and tests.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/url"
+	// Registers the "sqlite" driver with database/sql.
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/migrate"
+	_ "modernc.org/sqlite"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Open opens (creating it if needed) the SQLite database file at path. Passing ":memory:" gives a
+// private in-memory database, which is useful for tests.
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	params := url.Values{}
+	// Foreign keys are off by default in SQLite, but the schema relies on them to remove an
+	// order's delivery entries along with it.
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	// Times are written in a fixed format (rather than Go's time.String format) so they can be
+	// compared and sorted correctly within queries.
+	params.Set("_time_format", "sqlite")
+	// Starting transactions with a write lock avoids deadlocks when two transactions that began
+	// as readers both try to write.
+	params.Set("_txlock", "immediate")
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?%s", path, params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite database: %w", err)
+	}
+
+	if path == ":memory:" {
+		// Every connection to :memory: gets its own database, so the pool must be limited to a
+		// single connection for all callers to see the same data.
+		db.SetMaxOpenConns(1)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connecting to sqlite database: %w", err)
+	}
+
+	return db, nil
+}
+
+// NewMigrator creates a migrator for the order service's SQLite schema.
+func NewMigrator(db *sql.DB) (*migrate.Migrator, error) {
+	fsys, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := migrate.Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewMigrator(db, migrations), nil
+}
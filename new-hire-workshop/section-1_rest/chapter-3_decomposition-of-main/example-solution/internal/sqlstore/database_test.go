@@ -0,0 +1,83 @@
+package sqlstore_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/migrate"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/postgres"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlite"
+)
+
+// postgresDSNEnv names the environment variable with the DSN of a PostgreSQL database to run the
+// tests against. The PostgreSQL tests are skipped if it is not set.
+const postgresDSNEnv = "ORDERS_TEST_POSTGRES_DSN"
+
+// database is a migrated database that the stores can be tested against.
+type database struct {
+	name string
+	open func(t *testing.T) *sql.DB
+}
+
+var databases = []database{
+	{name: "SQLite", open: openSQLite},
+	{name: "PostgreSQL", open: openPostgres},
+}
+
+// forEachDatabase runs the test once for every database, as a subtest named after it.
+func forEachDatabase(t *testing.T, test func(t *testing.T, db *sql.DB)) {
+	for _, d := range databases {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			test(t, d.open(t))
+		})
+	}
+}
+
+// openSQLite returns a new in-memory SQLite database, which is thrown away when the test ends.
+func openSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	db, err := sqlite.Open(ctx, ":memory:")
+	if err != nil {
+		t.Fatalf("opening sqlite database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrateUp(t, db, sqlite.NewMigrator)
+	return db
+}
+
+func openPostgres(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNEnv)
+	}
+
+	db, err := postgres.Open(context.Background(), dsn)
+	if err != nil {
+		t.Fatalf("opening postgres database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrateUp(t, db, postgres.NewMigrator)
+	return db
+}
+
+func migrateUp(t *testing.T, db *sql.DB, newMigrator func(db *sql.DB) (*migrate.Migrator, error)) {
+	t.Helper()
+
+	migrator, err := newMigrator(db)
+	if err != nil {
+		t.Fatalf("loading migrations: %v", err)
+	}
+
+	if err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrating database: %v", err)
+	}
+}
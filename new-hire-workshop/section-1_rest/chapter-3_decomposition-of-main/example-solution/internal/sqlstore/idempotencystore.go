@@ -0,0 +1,148 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+)
+
+// IdempotencyKeyStore stores idempotency records in the idempotency_keys table, with the response
+// columns left null until the record is completed.
+type IdempotencyKeyStore struct {
+	db      *sql.DB
+	sweeper sweeper
+}
+
+var _ idempotency.KeyRepository = (*IdempotencyKeyStore)(nil)
+
+func NewIdempotencyKeyStore(db *sql.DB) *IdempotencyKeyStore {
+	return &IdempotencyKeyStore{
+		db: db,
+	}
+}
+
+// Claim relies on the primary key to decide which of two concurrent requests with the same key
+// gets to claim it, as the insert of the second one does nothing once the first has committed. An
+// expired record is taken over by the insert, and the rest are removed by a periodic sweep.
+func (s *IdempotencyKeyStore) Claim(ctx context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	now := time.Now().UTC()
+	if s.sweeper.due(now) {
+		if _, err := s.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now); err != nil {
+			return idempotency.Record{}, false, fmt.Errorf("deleting expired idempotency keys: %w", err)
+		}
+	}
+
+	var stored idempotency.Record
+	var claimed bool
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO idempotency_keys (tenant_id, customer_id, idempotency_key, fingerprint, expires_at)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (tenant_id, customer_id, idempotency_key) DO UPDATE
+				SET fingerprint = excluded.fingerprint, expires_at = excluded.expires_at,
+					status_code = NULL, content_type = NULL, body = NULL
+				WHERE idempotency_keys.expires_at <= $6`,
+			record.TenantID,
+			record.CustomerID,
+			record.Key,
+			record.Fingerprint,
+			record.ExpiresAt.UTC(),
+			now,
+		)
+		if err != nil {
+			return fmt.Errorf("inserting idempotency key: %w", err)
+		}
+
+		if inserted, err := res.RowsAffected(); err != nil {
+			return err
+		} else if inserted == 1 {
+			stored, claimed = record, true
+			return nil
+		}
+
+		stored, err = getIdempotencyRecord(ctx, tx, record.TenantID, record.CustomerID, record.Key)
+		return err
+	})
+	if err != nil {
+		return idempotency.Record{}, false, err
+	}
+
+	return stored, claimed, nil
+}
+
+func (s *IdempotencyKeyStore) Complete(ctx context.Context, record idempotency.Record) error {
+	res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE idempotency_keys SET status_code = $1, content_type = $2, body = $3
+			WHERE tenant_id = $4 AND customer_id = $5 AND idempotency_key = $6`,
+		record.StatusCode,
+		record.ContentType,
+		record.Body,
+		record.TenantID,
+		record.CustomerID,
+		record.Key,
+	)
+	if err != nil {
+		return fmt.Errorf("completing idempotency key: %w", err)
+	}
+
+	if updated, err := res.RowsAffected(); err != nil {
+		return err
+	} else if updated == 0 {
+		return terrors.NewNotFound("idempotency key", "key", record.Key, nil)
+	}
+
+	return nil
+}
+
+func (s *IdempotencyKeyStore) Release(ctx context.Context, tenantID, customerID, key string) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE tenant_id = $1 AND customer_id = $2 AND idempotency_key = $3`,
+		tenantID,
+		customerID,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("releasing idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func getIdempotencyRecord(ctx context.Context, tx *sql.Tx, tenantID, customerID, key string) (idempotency.Record, error) {
+	record := idempotency.Record{
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		Key:        key,
+	}
+
+	var statusCode sql.NullInt64
+	var contentType sql.NullString
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT fingerprint, expires_at, status_code, content_type, body FROM idempotency_keys
+			WHERE tenant_id = $1 AND customer_id = $2 AND idempotency_key = $3`,
+		tenantID,
+		customerID,
+		key,
+	).Scan(&record.Fingerprint, &record.ExpiresAt, &statusCode, &contentType, &record.Body)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The record that stopped the key from being claimed has been released since.
+		return idempotency.Record{}, terrors.NewStateConflict(fmt.Sprintf("idempotency key %s was released while being claimed", key), nil)
+	}
+
+	if err != nil {
+		return idempotency.Record{}, fmt.Errorf("querying idempotency key: %w", err)
+	}
+
+	record.StatusCode = int(statusCode.Int64)
+	record.ContentType = contentType.String
+	return record, nil
+}
@@ -0,0 +1,63 @@
+package sqlstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/migrate"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders/repositorytest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlite"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlstore"
+)
+
+// addTenantsVersion is the migration that adds tenants, whose rollback would mix the data of
+// every tenant together.
+const addTenantsVersion = 7
+
+// TestRemovingTenantsRefusesWithOtherTenants rolls back the SQLite migrations, as rolling back a
+// PostgreSQL database that is shared with other tests would break them.
+func TestRemovingTenantsRefusesWithOtherTenants(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLite(t)
+
+	migrator, err := sqlite.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("loading migrations: %v", err)
+	}
+
+	store := sqlstore.NewOrderStore(db)
+	order := repositorytest.NewOrder(repositorytest.NewTenantID(), "customer", repositorytest.Now())
+	repositorytest.MustCreate(t, store, order)
+
+	for version := migrationVersion(t, migrator); version > addTenantsVersion; version-- {
+		if err := migrator.Down(ctx); err != nil {
+			t.Fatalf("rolling back migration %d: %v", version, err)
+		}
+	}
+
+	if err := migrator.Down(ctx); err == nil {
+		t.Fatal("removing tenants succeeded with an order in another tenant")
+	}
+	if version := migrationVersion(t, migrator); version != addTenantsVersion {
+		t.Fatalf("database is at version %d after the rollback failed, want %d", version, addTenantsVersion)
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM orders WHERE order_id = $1`, order.OrderID); err != nil {
+		t.Fatalf("deleting order: %v", err)
+	}
+
+	if err := migrator.Down(ctx); err != nil {
+		t.Fatalf("removing tenants returned error once only the default tenant was left: %v", err)
+	}
+}
+
+func migrationVersion(t *testing.T, migrator *migrate.Migrator) int {
+	t.Helper()
+
+	version, err := migrator.Version(context.Background())
+	if err != nil {
+		t.Fatalf("getting migration version: %v", err)
+	}
+
+	return version
+}
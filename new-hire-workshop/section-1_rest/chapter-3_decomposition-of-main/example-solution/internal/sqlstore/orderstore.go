@@ -0,0 +1,406 @@
+// Package sqlstore provides the order, idempotency key, webhook and rate limit stores on top of
+// database/sql.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// OrderStore stores orders in the orders table, with each order's delivery entries held
+// separately in the delivery_entries table.
+type OrderStore struct {
+	db *sql.DB
+}
+
+var _ orders.OrderRepository = (*OrderStore)(nil)
+
+func NewOrderStore(db *sql.DB) *OrderStore {
+	return &OrderStore{
+		db: db,
+	}
+}
+
+const orderColumns = `tenant_id, order_id, customer_id, address, product_id, payment_id, status, status_reason,
+	ordered_at, delivered_at, version`
+
+func (s *OrderStore) ListOrders(ctx context.Context, tenantID, customerID string, opts orders.ListOptions) (orders.OrderPage, error) {
+	query := &queryBuilder{}
+	query.where("tenant_id = %s", tenantID)
+	query.where("customer_id = %s", customerID)
+
+	if len(opts.Statuses) > 0 {
+		placeholders := make([]string, 0, len(opts.Statuses))
+		for _, status := range opts.Statuses {
+			placeholders = append(placeholders, query.arg(string(status)))
+		}
+		query.conditions = append(query.conditions, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if !opts.OrderedFrom.IsZero() {
+		query.where("ordered_at >= %s", opts.OrderedFrom.UTC())
+	}
+
+	if !opts.OrderedBefore.IsZero() {
+		query.where("ordered_at < %s", opts.OrderedBefore.UTC())
+	}
+
+	direction, comparison := "ASC", ">"
+	if opts.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if opts.After != nil {
+		query.conditions = append(query.conditions, fmt.Sprintf(
+			"(ordered_at, order_id) %s (%s, %s)",
+			comparison,
+			query.arg(opts.After.OrderedAt.UTC()),
+			query.arg(opts.After.OrderID),
+		))
+	}
+
+	sqlQuery := fmt.Sprintf(
+		`SELECT %s FROM orders WHERE %s ORDER BY ordered_at %s, order_id %s`,
+		orderColumns,
+		strings.Join(query.conditions, " AND "),
+		direction,
+		direction,
+	)
+
+	// One more order than the limit is fetched, to find out whether there is another page.
+	if opts.Limit > 0 {
+		sqlQuery += " LIMIT " + query.arg(opts.Limit+1)
+	}
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, query.args...)
+	if err != nil {
+		return orders.OrderPage{}, fmt.Errorf("querying orders: %w", err)
+	}
+
+	found, err := scanOrders(rows)
+	if err != nil {
+		return orders.OrderPage{}, err
+	}
+
+	page := orders.OrderPage{Orders: found}
+	if opts.Limit > 0 && len(found) > opts.Limit {
+		page.Orders = found[:opts.Limit]
+		last := page.Orders[len(page.Orders)-1]
+		page.Next = &orders.Cursor{OrderedAt: last.OrderedAt, OrderID: last.OrderID}
+	}
+
+	if len(page.Orders) == 0 {
+		return page, nil
+	}
+
+	entriesQuery := &queryBuilder{}
+	placeholders := make([]string, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		placeholders = append(placeholders, entriesQuery.arg(order.OrderID))
+	}
+
+	entries, err := s.deliveryEntries(
+		ctx,
+		fmt.Sprintf(
+			`SELECT order_id, occurred_at, message FROM delivery_entries WHERE order_id IN (%s) ORDER BY order_id, seq`,
+			strings.Join(placeholders, ", "),
+		),
+		entriesQuery.args...,
+	)
+	if err != nil {
+		return orders.OrderPage{}, err
+	}
+
+	for i := range page.Orders {
+		page.Orders[i].DeliveryEntries = entries[page.Orders[i].OrderID]
+	}
+
+	return page, nil
+}
+
+// GetOrder returns the order with the specified ID. An order that belongs to a different customer
+// is reported as not found, so that customers cannot discover the IDs of other customers' orders.
+func (s *OrderStore) GetOrder(ctx context.Context, tenantID, customerID, orderID string) (orders.Order, error) {
+	return s.getOrder(ctx, `WHERE order_id = $1 AND tenant_id = $2 AND customer_id = $3`, orderID, tenantID, customerID)
+}
+
+func (s *OrderStore) GetOrderByID(ctx context.Context, tenantID, orderID string) (orders.Order, error) {
+	return s.getOrder(ctx, `WHERE order_id = $1 AND tenant_id = $2`, orderID, tenantID)
+}
+
+// getOrder returns the order matched by the WHERE clause, whose first argument must be the order
+// ID.
+func (s *OrderStore) getOrder(ctx context.Context, where string, orderID string, args ...interface{}) (orders.Order, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+orderColumns+` FROM orders `+where,
+		append([]interface{}{orderID}, args...)...,
+	)
+	if err != nil {
+		return orders.Order{}, fmt.Errorf("querying order: %w", err)
+	}
+
+	found, err := scanOrders(rows)
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	if len(found) == 0 {
+		return orders.Order{}, terrors.Presentable(terrors.NewNotFound("order", "id", orderID, nil))
+	}
+
+	entries, err := s.deliveryEntries(
+		ctx,
+		`SELECT order_id, occurred_at, message FROM delivery_entries WHERE order_id = $1 ORDER BY seq`,
+		orderID,
+	)
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	order := found[0]
+	order.DeliveryEntries = entries[orderID]
+	return order, nil
+}
+
+func (s *OrderStore) CreateOrder(ctx context.Context, order orders.Order) error {
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO orders (`+orderColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				ON CONFLICT (order_id) DO NOTHING`,
+			order.TenantID,
+			order.OrderID,
+			order.CustomerID,
+			order.Address,
+			order.ProductID,
+			order.PaymentID,
+			string(order.Status),
+			order.StatusReason,
+			order.OrderedAt.UTC(),
+			nullTime(order.DeliveredAt),
+			order.Version,
+		)
+		if err != nil {
+			return fmt.Errorf("inserting order: %w", err)
+		}
+
+		if inserted, err := res.RowsAffected(); err != nil {
+			return err
+		} else if inserted == 0 {
+			return terrors.Presentable(terrors.NewStateConflict(fmt.Sprintf("order %s already exists", order.OrderID), nil))
+		}
+
+		return insertDeliveryEntries(ctx, tx, order)
+	})
+	if err != nil {
+		return err
+	}
+
+	orders.LogOrderWrite(ctx, "created order", order)
+	return nil
+}
+
+func (s *OrderStore) UpdateOrder(ctx context.Context, order orders.Order) (orders.Order, error) {
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var currentStatus string
+		var currentVersion int
+		err := tx.QueryRowContext(
+			ctx,
+			`SELECT status, version FROM orders WHERE order_id = $1 AND tenant_id = $2 AND customer_id = $3`,
+			order.OrderID,
+			order.TenantID,
+			order.CustomerID,
+		).Scan(&currentStatus, &currentVersion)
+		if errors.Is(err, sql.ErrNoRows) {
+			return terrors.Presentable(terrors.NewNotFound("order", "id", order.OrderID, nil))
+		}
+
+		if err != nil {
+			return fmt.Errorf("querying order status: %w", err)
+		}
+
+		if currentVersion != order.Version {
+			return orders.NewVersionConflict(order.OrderID)
+		}
+
+		if err := orders.ValidateTransition(orders.OrderStatus(currentStatus), order.Status); err != nil {
+			return err
+		}
+
+		// The update is conditional on the version not having changed since it was read, so two
+		// concurrent updates of the same version cannot both succeed.
+		res, err := tx.ExecContext(
+			ctx,
+			`UPDATE orders SET address = $1, product_id = $2, payment_id = $3, status = $4,
+				status_reason = $5, ordered_at = $6, delivered_at = $7, version = version + 1
+				WHERE order_id = $8 AND tenant_id = $9 AND customer_id = $10 AND version = $11`,
+			order.Address,
+			order.ProductID,
+			order.PaymentID,
+			string(order.Status),
+			order.StatusReason,
+			order.OrderedAt.UTC(),
+			nullTime(order.DeliveredAt),
+			order.OrderID,
+			order.TenantID,
+			order.CustomerID,
+			order.Version,
+		)
+		if err != nil {
+			return fmt.Errorf("updating order: %w", err)
+		}
+
+		if updated, err := res.RowsAffected(); err != nil {
+			return err
+		} else if updated == 0 {
+			return orders.NewVersionConflict(order.OrderID)
+		}
+
+		// The delivery entries are replaced wholesale, which keeps the update simple and means the
+		// stored entries always match the order that was passed in.
+		if _, err := tx.ExecContext(ctx, `DELETE FROM delivery_entries WHERE order_id = $1`, order.OrderID); err != nil {
+			return fmt.Errorf("deleting delivery entries: %w", err)
+		}
+
+		return insertDeliveryEntries(ctx, tx, order)
+	})
+	if err != nil {
+		return orders.Order{}, err
+	}
+
+	order.Version++
+	orders.LogOrderWrite(ctx, "updated order", order)
+	return order, nil
+}
+
+func (s *OrderStore) deliveryEntries(ctx context.Context, query string, args ...interface{}) (map[string][]orders.DeliveryEntry, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying delivery entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := map[string][]orders.DeliveryEntry{}
+	for rows.Next() {
+		var orderID string
+		var entry orders.DeliveryEntry
+		if err := rows.Scan(&orderID, &entry.Timestamp, &entry.Message); err != nil {
+			return nil, fmt.Errorf("scanning delivery entry: %w", err)
+		}
+
+		entries[orderID] = append(entries[orderID], entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading delivery entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func insertDeliveryEntries(ctx context.Context, tx *sql.Tx, order orders.Order) error {
+	for i, entry := range order.DeliveryEntries {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO delivery_entries (order_id, seq, occurred_at, message) VALUES ($1, $2, $3, $4)`,
+			order.OrderID,
+			i,
+			entry.Timestamp.UTC(),
+			entry.Message,
+		)
+		if err != nil {
+			return fmt.Errorf("inserting delivery entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func scanOrders(rows *sql.Rows) ([]orders.Order, error) {
+	defer rows.Close()
+
+	var found []orders.Order
+	for rows.Next() {
+		var order orders.Order
+		var status string
+		var deliveredAt sql.NullTime
+		err := rows.Scan(
+			&order.TenantID,
+			&order.OrderID,
+			&order.CustomerID,
+			&order.Address,
+			&order.ProductID,
+			&order.PaymentID,
+			&status,
+			&order.StatusReason,
+			&order.OrderedAt,
+			&deliveredAt,
+			&order.Version,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning order: %w", err)
+		}
+
+		order.Status = orders.OrderStatus(status)
+		if deliveredAt.Valid {
+			order.DeliveredAt = deliveredAt.Time
+		}
+
+		found = append(found, order)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading orders: %w", err)
+	}
+
+	return found, nil
+}
+
+// queryBuilder collects the conditions and arguments of a query whose filters are only known at
+// run time, numbering the placeholders as it goes.
+type queryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// arg adds an argument to the query, returning the placeholder to use for it.
+func (q *queryBuilder) arg(value interface{}) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where adds a condition with a single argument, which replaces the %s in the condition.
+func (q *queryBuilder) where(condition string, value interface{}) {
+	q.conditions = append(q.conditions, fmt.Sprintf(condition, q.arg(value)))
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+func inTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		// The error from the rollback is ignored, as the error that caused it is more useful.
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,15 @@
+package sqlstore_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders/repositorytest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlstore"
+)
+
+func TestOrderStoreRepository(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db *sql.DB) {
+		repositorytest.TestOrderRepository(t, sqlstore.NewOrderStore(db))
+	})
+}
@@ -0,0 +1,131 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+)
+
+// maxTakeAttempts is how many times taking a token is tried when other requests keep changing the
+// bucket first.
+const maxTakeAttempts = 5
+
+// RateLimitStore stores token buckets in the rate_limit_buckets table, so that every server that
+// shares the database enforces the same limits.
+type RateLimitStore struct {
+	db      *sql.DB
+	sweeper sweeper
+}
+
+var _ ratelimit.BucketRepository = (*RateLimitStore)(nil)
+
+func NewRateLimitStore(db *sql.DB) *RateLimitStore {
+	return &RateLimitStore{
+		db: db,
+	}
+}
+
+// Take takes a token with an update that is conditional on the bucket not having changed since it
+// was read.
+func (s *RateLimitStore) Take(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Decision, error) {
+	// The buckets that have filled up again are no different from new ones, so they are removed.
+	if now := time.Now().UTC(); s.sweeper.due(now) {
+		if _, err := s.db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE full_at <= $1`, now); err != nil {
+			return ratelimit.Decision{}, fmt.Errorf("deleting full rate limit buckets: %w", err)
+		}
+	}
+
+	for attempt := 0; attempt < maxTakeAttempts; attempt++ {
+		decision, taken, err := s.tryTake(ctx, key, limit)
+		if err != nil {
+			return ratelimit.Decision{}, err
+		}
+
+		if taken {
+			return decision, nil
+		}
+	}
+
+	return ratelimit.Decision{}, terrors.NewStateConflict(fmt.Sprintf("rate limit bucket %s kept changing while taking a token", key), nil)
+}
+
+func (s *RateLimitStore) Check(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Decision, error) {
+	current, _, err := s.get(ctx, key)
+	if err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	return current.Check(limit, time.Now().UTC()), nil
+}
+
+// get returns the bucket with the key, reporting whether it is stored. A bucket that is not stored
+// is full.
+func (s *RateLimitStore) get(ctx context.Context, key string) (ratelimit.Bucket, bool, error) {
+	var bucket ratelimit.Bucket
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT tokens, updated_at FROM rate_limit_buckets WHERE bucket_key = $1`,
+		key,
+	).Scan(&bucket.Tokens, &bucket.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ratelimit.Bucket{}, false, nil
+	} else if err != nil {
+		return ratelimit.Bucket{}, false, fmt.Errorf("querying rate limit bucket: %w", err)
+	}
+
+	return bucket, true, nil
+}
+
+// tryTake makes a single attempt at taking a token, reporting whether the bucket was stored.
+func (s *RateLimitStore) tryTake(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Decision, bool, error) {
+	current, found, err := s.get(ctx, key)
+	if err != nil {
+		return ratelimit.Decision{}, false, err
+	}
+
+	now := time.Now().UTC()
+	bucket, decision := current.Take(limit, now)
+
+	var res sql.Result
+	if found {
+		res, err = s.db.ExecContext(
+			ctx,
+			`UPDATE rate_limit_buckets SET tokens = $1, updated_at = $2, full_at = $3
+				WHERE bucket_key = $4 AND updated_at = $5`,
+			bucket.Tokens,
+			bucket.UpdatedAt,
+			bucket.FullAt(limit).UTC(),
+			key,
+			current.UpdatedAt,
+		)
+		if err != nil {
+			return ratelimit.Decision{}, false, fmt.Errorf("updating rate limit bucket: %w", err)
+		}
+	} else {
+		res, err = s.db.ExecContext(
+			ctx,
+			`INSERT INTO rate_limit_buckets (bucket_key, tokens, updated_at, full_at)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (bucket_key) DO NOTHING`,
+			key,
+			bucket.Tokens,
+			bucket.UpdatedAt,
+			bucket.FullAt(limit).UTC(),
+		)
+		if err != nil {
+			return ratelimit.Decision{}, false, fmt.Errorf("inserting rate limit bucket: %w", err)
+		}
+	}
+
+	stored, err := res.RowsAffected()
+	if err != nil {
+		return ratelimit.Decision{}, false, err
+	}
+
+	return decision, stored == 1, nil
+}
@@ -0,0 +1,29 @@
+package sqlstore
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often a store removes the rows that are no longer needed.
+const sweepInterval = time.Minute
+
+// sweeper tells a store when to remove the rows that are no longer needed, so that it does so at
+// most once every sweepInterval rather than on every write.
+type sweeper struct {
+	mu        sync.Mutex
+	nextSweep time.Time
+}
+
+// due reports whether a sweep is due at now, counting it as done if it is.
+func (s *sweeper) due(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Before(s.nextSweep) {
+		return false
+	}
+
+	s.nextSweep = now.Add(sweepInterval)
+	return true
+}
@@ -0,0 +1,328 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+)
+
+// WebhookStore stores webhook subscriptions in the webhook_subscriptions table, and their
+// deliveries in the webhook_deliveries table.
+type WebhookStore struct {
+	db *sql.DB
+}
+
+var _ webhooks.Repository = (*WebhookStore)(nil)
+
+func NewWebhookStore(db *sql.DB) *WebhookStore {
+	return &WebhookStore{
+		db: db,
+	}
+}
+
+const subscriptionColumns = `subscription_id, tenant_id, customer_id, url, secret, created_at`
+
+const deliveryColumns = `delivery_id, subscription_id, tenant_id, customer_id, event_id, event_type, payload, status,
+	attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at`
+
+func (s *WebhookStore) CreateSubscription(ctx context.Context, sub webhooks.Subscription) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO webhook_subscriptions (`+subscriptionColumns+`) VALUES ($1, $2, $3, $4, $5, $6)`,
+		sub.ID,
+		sub.TenantID,
+		sub.CustomerID,
+		sub.URL,
+		sub.Secret,
+		sub.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("inserting webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) ListSubscriptions(ctx context.Context, tenantID, customerID string) ([]webhooks.Subscription, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+subscriptionColumns+` FROM webhook_subscriptions
+			WHERE tenant_id = $1 AND customer_id = $2 ORDER BY created_at, subscription_id`,
+		tenantID,
+		customerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []webhooks.Subscription{}
+	for rows.Next() {
+		var sub webhooks.Subscription
+		if err := rows.Scan(&sub.ID, &sub.TenantID, &sub.CustomerID, &sub.URL, &sub.Secret, &sub.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning webhook subscription: %w", err)
+		}
+
+		subs = append(subs, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading webhook subscriptions: %w", err)
+	}
+
+	return subs, nil
+}
+
+func (s *WebhookStore) GetSubscription(ctx context.Context, tenantID, customerID, subscriptionID string) (webhooks.Subscription, error) {
+	var sub webhooks.Subscription
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT `+subscriptionColumns+` FROM webhook_subscriptions
+			WHERE subscription_id = $1 AND tenant_id = $2 AND customer_id = $3`,
+		subscriptionID,
+		tenantID,
+		customerID,
+	).Scan(&sub.ID, &sub.TenantID, &sub.CustomerID, &sub.URL, &sub.Secret, &sub.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return webhooks.Subscription{}, terrors.Presentable(terrors.NewNotFound("webhook", "id", subscriptionID, nil))
+	}
+
+	if err != nil {
+		return webhooks.Subscription{}, fmt.Errorf("querying webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+// DeleteSubscription relies on the foreign key from webhook_deliveries to delete the deliveries.
+func (s *WebhookStore) DeleteSubscription(ctx context.Context, tenantID, customerID, subscriptionID string) error {
+	res, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM webhook_subscriptions WHERE subscription_id = $1 AND tenant_id = $2 AND customer_id = $3`,
+		subscriptionID,
+		tenantID,
+		customerID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting webhook subscription: %w", err)
+	}
+
+	if deleted, err := res.RowsAffected(); err != nil {
+		return err
+	} else if deleted == 0 {
+		return terrors.Presentable(terrors.NewNotFound("webhook", "id", subscriptionID, nil))
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) CreateDeliveries(ctx context.Context, deliveries []webhooks.Delivery) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		for _, delivery := range deliveries {
+			_, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO webhook_deliveries (`+deliveryColumns+`)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+				delivery.ID,
+				delivery.SubscriptionID,
+				delivery.TenantID,
+				delivery.CustomerID,
+				delivery.EventID,
+				string(delivery.EventType),
+				delivery.Payload,
+				string(delivery.Status),
+				delivery.Attempts,
+				delivery.NextAttemptAt.UTC(),
+				delivery.LastStatusCode,
+				delivery.LastError,
+				delivery.CreatedAt.UTC(),
+				delivery.UpdatedAt.UTC(),
+			)
+			if err != nil {
+				return fmt.Errorf("inserting webhook delivery: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *WebhookStore) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]webhooks.Delivery, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries
+			WHERE subscription_id = $1 ORDER BY created_at DESC, delivery_id LIMIT $2`,
+		subscriptionID,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying webhook deliveries: %w", err)
+	}
+
+	return scanDeliveries(rows)
+}
+
+// ClaimDueDeliveries claims each due delivery with an update that is conditional on it not having
+// been claimed since it was read.
+func (s *WebhookStore) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhooks.Delivery, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries
+			WHERE status = $1 AND next_attempt_at <= $2 ORDER BY next_attempt_at LIMIT $3`,
+		string(webhooks.DeliveryStatusPending),
+		now.UTC(),
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying due webhook deliveries: %w", err)
+	}
+
+	due, err := scanDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]webhooks.Delivery, 0, len(due))
+	for _, delivery := range due {
+		res, err := s.db.ExecContext(
+			ctx,
+			`UPDATE webhook_deliveries SET next_attempt_at = $1
+				WHERE delivery_id = $2 AND status = $3 AND next_attempt_at = $4`,
+			leaseUntil.UTC(),
+			delivery.ID,
+			string(webhooks.DeliveryStatusPending),
+			delivery.NextAttemptAt.UTC(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("claiming webhook delivery: %w", err)
+		}
+
+		if updated, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if updated == 1 {
+			delivery.NextAttemptAt = leaseUntil
+			claimed = append(claimed, delivery)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (s *WebhookStore) UpdateDelivery(ctx context.Context, delivery webhooks.Delivery) error {
+	res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries SET status = $1, attempts = $2, next_attempt_at = $3,
+			last_status_code = $4, last_error = $5, updated_at = $6
+			WHERE delivery_id = $7`,
+		string(delivery.Status),
+		delivery.Attempts,
+		delivery.NextAttemptAt.UTC(),
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.UpdatedAt.UTC(),
+		delivery.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("updating webhook delivery: %w", err)
+	}
+
+	if updated, err := res.RowsAffected(); err != nil {
+		return err
+	} else if updated == 0 {
+		return terrors.NewNotFound("webhook delivery", "id", delivery.ID, nil)
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) ReplayDeliveries(ctx context.Context, subscriptionID string, at time.Time) ([]webhooks.Delivery, error) {
+	var replayed []webhooks.Delivery
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(
+			ctx,
+			`SELECT `+deliveryColumns+` FROM webhook_deliveries
+				WHERE subscription_id = $1 AND status = $2 ORDER BY created_at DESC, delivery_id`,
+			subscriptionID,
+			string(webhooks.DeliveryStatusDead),
+		)
+		if err != nil {
+			return fmt.Errorf("querying dead webhook deliveries: %w", err)
+		}
+
+		if replayed, err = scanDeliveries(rows); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE webhook_deliveries SET status = $1, attempts = 0, next_attempt_at = $2, updated_at = $2
+				WHERE subscription_id = $3 AND status = $4`,
+			string(webhooks.DeliveryStatusPending),
+			at.UTC(),
+			subscriptionID,
+			string(webhooks.DeliveryStatusDead),
+		)
+		if err != nil {
+			return fmt.Errorf("replaying webhook deliveries: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range replayed {
+		replayed[i].Status = webhooks.DeliveryStatusPending
+		replayed[i].Attempts = 0
+		replayed[i].NextAttemptAt = at
+		replayed[i].UpdatedAt = at
+	}
+
+	return replayed, nil
+}
+
+func scanDeliveries(rows *sql.Rows) ([]webhooks.Delivery, error) {
+	defer rows.Close()
+
+	deliveries := []webhooks.Delivery{}
+	for rows.Next() {
+		var delivery webhooks.Delivery
+		var eventType, status string
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.SubscriptionID,
+			&delivery.TenantID,
+			&delivery.CustomerID,
+			&delivery.EventID,
+			&eventType,
+			&delivery.Payload,
+			&status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.LastStatusCode,
+			&delivery.LastError,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning webhook delivery: %w", err)
+		}
+
+		delivery.EventType = orders.EventType(eventType)
+		delivery.Status = webhooks.DeliveryStatus(status)
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,15 @@
+package sqlstore_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/sqlstore"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks/repositorytest"
+)
+
+func TestWebhookStoreRepository(t *testing.T) {
+	forEachDatabase(t, func(t *testing.T, db *sql.DB) {
+		repositorytest.TestRepository(t, sqlstore.NewWebhookStore(db))
+	})
+}
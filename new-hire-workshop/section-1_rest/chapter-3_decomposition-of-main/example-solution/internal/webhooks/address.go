@@ -0,0 +1,106 @@
+package webhooks
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// blockedNetworks are the ranges that the net.IP methods do not cover, but are no more reachable
+// from the internet than a private range is.
+var blockedNetworks = []*net.IPNet{
+	// "This network", which some systems route to the host itself.
+	mustParseCIDR("0.0.0.0/8"),
+	// The range that carriers use for NAT between their customers.
+	mustParseCIDR("100.64.0.0/10"),
+	// NAT64, which translates to the IPv4 address in its last 32 bits, whatever range it is in.
+	mustParseCIDR("64:ff9b::/96"),
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return network
+}
+
+// isPublicAddress reports whether an address could belong to a customer's receiver on the
+// internet, rather than to this server, its network or its cloud provider's metadata service.
+func isPublicAddress(ip net.IP) bool {
+	if ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newDeliveryClient creates the client that deliveries are sent with, which refuses to connect to
+// any address that allowed rejects.
+func newDeliveryClient(allowed func(net.IP) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !allowed(ip) {
+				return fmt.Errorf("refusing to connect to %s, as it is not a public address", host)
+			}
+
+			return nil
+		},
+	}
+
+	// No proxy is used, as the proxy would be the one to connect to the receiver, without its
+	// address being checked.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: transport,
+		// A redirect is treated as a failure, as following it would send the signed payload
+		// somewhere the customer did not subscribe.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// validateSubscriptionURL rejects a URL whose host is plainly not a public address.
+func validateSubscriptionURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return terrors.Presentable(terrors.NewInvalidSingleInput("url", "is not a valid URL", err))
+	}
+
+	host := parsed.Hostname()
+	ip := net.ParseIP(host)
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") || (ip != nil && !isPublicAddress(ip)) {
+		return terrors.Presentable(terrors.NewInvalidSingleInput("url", "must not be a loopback, private or link-local address", nil))
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package webhooks_test
+
+import (
+	"testing"
+
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks/repositorytest"
+)
+
+func TestStoreRepository(t *testing.T) {
+	repositorytest.TestRepository(t, webhooks.NewStore())
+}
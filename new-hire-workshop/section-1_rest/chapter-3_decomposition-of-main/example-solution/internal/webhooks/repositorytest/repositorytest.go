@@ -0,0 +1,216 @@
+// Package repositorytest is a suite of tests for the contract of webhooks.Repository, in the same
+// way as the orders package's repositorytest is for orders.OrderRepository.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+)
+
+// TestRepository runs the contract tests against the repository, each under a tenant of its own.
+func TestRepository(t *testing.T, repo webhooks.Repository) {
+	t.Run("CreateAndGet", func(t *testing.T) { testCreateAndGet(t, repo) })
+	t.Run("OtherCustomersSubscription", func(t *testing.T) { testOtherCustomersSubscription(t, repo) })
+	t.Run("OtherTenantsSubscription", func(t *testing.T) { testOtherTenantsSubscription(t, repo) })
+	t.Run("Delete", func(t *testing.T) { testDelete(t, repo) })
+	t.Run("DeliveriesAndReplay", func(t *testing.T) { testDeliveriesAndReplay(t, repo) })
+}
+
+func testCreateAndGet(t *testing.T, repo webhooks.Repository) {
+	ctx := context.Background()
+	sub := mustSubscribe(t, repo, newTenantID(), uuid.NewString())
+
+	got, err := repo.GetSubscription(ctx, sub.TenantID, sub.CustomerID, sub.ID)
+	if err != nil {
+		t.Fatalf("GetSubscription returned error: %v", err)
+	}
+	assertSubscriptionEqual(t, got, sub)
+
+	subs, err := repo.ListSubscriptions(ctx, sub.TenantID, sub.CustomerID)
+	if err != nil {
+		t.Fatalf("ListSubscriptions returned error: %v", err)
+	}
+	if len(subs) != 1 {
+		t.Fatalf("listed %d subscriptions, want 1", len(subs))
+	}
+	assertSubscriptionEqual(t, subs[0], sub)
+}
+
+func testOtherCustomersSubscription(t *testing.T, repo webhooks.Repository) {
+	sub := mustSubscribe(t, repo, newTenantID(), uuid.NewString())
+	assertHidden(t, repo, sub, sub.TenantID, uuid.NewString())
+}
+
+// testOtherTenantsSubscription checks that a subscription cannot be reached from another tenant,
+// even by a customer with the same ID, as customer IDs are only unique within a tenant.
+func testOtherTenantsSubscription(t *testing.T, repo webhooks.Repository) {
+	sub := mustSubscribe(t, repo, newTenantID(), uuid.NewString())
+	assertHidden(t, repo, sub, newTenantID(), sub.CustomerID)
+}
+
+func testDelete(t *testing.T, repo webhooks.Repository) {
+	ctx := context.Background()
+	sub := mustSubscribe(t, repo, newTenantID(), uuid.NewString())
+	mustCreateDeliveries(t, repo, newDelivery(sub, now()))
+
+	if err := repo.DeleteSubscription(ctx, sub.TenantID, sub.CustomerID, sub.ID); err != nil {
+		t.Fatalf("DeleteSubscription returned error: %v", err)
+	}
+
+	_, err := repo.GetSubscription(ctx, sub.TenantID, sub.CustomerID, sub.ID)
+	assertNotFound(t, err)
+
+	deliveries, err := repo.ListDeliveries(ctx, sub.ID, 10)
+	if err != nil {
+		t.Fatalf("ListDeliveries returned error: %v", err)
+	}
+	if len(deliveries) != 0 {
+		t.Errorf("deleted subscription has %d deliveries, want none", len(deliveries))
+	}
+
+	assertNotFound(t, repo.DeleteSubscription(ctx, sub.TenantID, sub.CustomerID, sub.ID))
+}
+
+func testDeliveriesAndReplay(t *testing.T, repo webhooks.Repository) {
+	ctx := context.Background()
+	sub := mustSubscribe(t, repo, newTenantID(), uuid.NewString())
+
+	createdAt := now()
+	older, newer := newDelivery(sub, createdAt.Add(-time.Minute)), newDelivery(sub, createdAt)
+	mustCreateDeliveries(t, repo, older, newer)
+
+	deliveries, err := repo.ListDeliveries(ctx, sub.ID, 10)
+	if err != nil {
+		t.Fatalf("ListDeliveries returned error: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[0].ID != newer.ID || deliveries[1].ID != older.ID {
+		t.Fatalf("listed deliveries %+v, want the newer delivery then the older one", deliveries)
+	}
+
+	older.Status = webhooks.DeliveryStatusDead
+	older.Attempts = 3
+	older.LastStatusCode = 500
+	older.LastError = "receiver responded with 500"
+	if err := repo.UpdateDelivery(ctx, older); err != nil {
+		t.Fatalf("UpdateDelivery returned error: %v", err)
+	}
+
+	replayAt := now()
+	replayed, err := repo.ReplayDeliveries(ctx, sub.ID, replayAt)
+	if err != nil {
+		t.Fatalf("ReplayDeliveries returned error: %v", err)
+	}
+	if len(replayed) != 1 || replayed[0].ID != older.ID {
+		t.Fatalf("replayed deliveries %+v, want only the dead one", replayed)
+	}
+
+	deliveries, err = repo.ListDeliveries(ctx, sub.ID, 1)
+	if err != nil {
+		t.Fatalf("ListDeliveries returned error: %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].ID != newer.ID {
+		t.Errorf("listed deliveries %+v with a limit of 1, want only the newer delivery", deliveries)
+	}
+
+	missing := newDelivery(sub, now())
+	assertNotFound(t, repo.UpdateDelivery(ctx, missing))
+}
+
+// assertHidden fails the test if the subscription can be reached by the tenant's customer.
+func assertHidden(t *testing.T, repo webhooks.Repository, sub webhooks.Subscription, tenantID, customerID string) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := repo.GetSubscription(ctx, tenantID, customerID, sub.ID)
+	assertNotFound(t, err)
+
+	assertNotFound(t, repo.DeleteSubscription(ctx, tenantID, customerID, sub.ID))
+
+	subs, err := repo.ListSubscriptions(ctx, tenantID, customerID)
+	if err != nil {
+		t.Fatalf("ListSubscriptions returned error: %v", err)
+	}
+	if len(subs) != 0 {
+		t.Errorf("listed %d subscriptions, want none", len(subs))
+	}
+
+	// The subscription is still there for its own customer, despite the attempt to delete it.
+	if _, err := repo.GetSubscription(ctx, sub.TenantID, sub.CustomerID, sub.ID); err != nil {
+		t.Errorf("GetSubscription returned error for the subscription's own customer: %v", err)
+	}
+}
+
+func newTenantID() string {
+	return "test-" + uuid.NewString()
+}
+
+// now returns the current time, cut down to the microseconds that every store can hold.
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+func mustSubscribe(t *testing.T, repo webhooks.Repository, tenantID, customerID string) webhooks.Subscription {
+	t.Helper()
+
+	sub, err := webhooks.NewSubscription(tenantID, customerID, "https://hooks.example.com/orders")
+	if err != nil {
+		t.Fatalf("NewSubscription returned error: %v", err)
+	}
+	sub.CreatedAt = sub.CreatedAt.Truncate(time.Microsecond)
+
+	if err := repo.CreateSubscription(context.Background(), sub); err != nil {
+		t.Fatalf("CreateSubscription returned error: %v", err)
+	}
+
+	return sub
+}
+
+func newDelivery(sub webhooks.Subscription, createdAt time.Time) webhooks.Delivery {
+	return webhooks.Delivery{
+		ID:             uuid.NewString(),
+		SubscriptionID: sub.ID,
+		TenantID:       sub.TenantID,
+		CustomerID:     sub.CustomerID,
+		EventID:        uuid.NewString(),
+		EventType:      orders.EventTypeOrderPlaced,
+		Payload:        []byte(`{}`),
+		Status:         webhooks.DeliveryStatusPending,
+		NextAttemptAt:  createdAt.Add(time.Hour),
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+	}
+}
+
+func mustCreateDeliveries(t *testing.T, repo webhooks.Repository, deliveries ...webhooks.Delivery) {
+	t.Helper()
+
+	if err := repo.CreateDeliveries(context.Background(), deliveries); err != nil {
+		t.Fatalf("CreateDeliveries returned error: %v", err)
+	}
+}
+
+func assertNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	var notFound *terrors.NotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("got error %v, want a terrors.NotFound", err)
+	}
+}
+
+func assertSubscriptionEqual(t *testing.T, got, want webhooks.Subscription) {
+	t.Helper()
+
+	gotCreatedAt, wantCreatedAt := got.CreatedAt, want.CreatedAt
+	got.CreatedAt, want.CreatedAt = time.Time{}, time.Time{}
+	if got != want || !gotCreatedAt.Equal(wantCreatedAt) {
+		t.Errorf("got subscription %+v created at %s, want %+v created at %s", got, gotCreatedAt, want, wantCreatedAt)
+	}
+}
@@ -0,0 +1,330 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+const (
+	// deliveryTimeout is how long a receiver has to respond to a delivery.
+	deliveryTimeout = 10 * time.Second
+	// deliveryLease is how long a claimed delivery is left for the server that claimed it to send.
+	// It is long enough for a whole batch to time out, after which the delivery is due again in
+	// case that server stopped before recording the attempt.
+	deliveryLease = time.Minute
+	// pollInterval is how often the store is checked for deliveries that have fallen due.
+	pollInterval   = time.Second
+	claimBatchSize = 20
+	// deliveryLogLimit is how many of the most recent deliveries are shown in a delivery log.
+	deliveryLogLimit = 100
+	// maxSubscriptions is how many subscriptions each customer can have, which bounds how many
+	// deliveries a single order event can make.
+	maxSubscriptions = 10
+)
+
+// Encoder encodes an order event as the payload of its deliveries. Every subscription is sent the
+// same payload, which includes the event ID so receivers can tell retries apart from new events.
+type Encoder func(eventID string, event orders.Event) ([]byte, error)
+
+// RetryPolicy decides how often and how quickly a failed delivery is retried.
+type RetryPolicy struct {
+	// MaxAttempts is how many times a delivery is tried before it is dead-lettered.
+	MaxAttempts int
+	// BaseDelay is how long to wait after the first failed attempt. The wait doubles with each
+	// attempt after that, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// backoff returns how long to wait before retrying a delivery after the given number of failed
+// attempts, with jitter.
+func (p RetryPolicy) backoff(attempts int) time.Duration {
+	delay := p.MaxDelay
+	if shift := attempts - 1; shift < 32 {
+		if doubled := p.BaseDelay << shift; doubled > 0 && doubled < p.MaxDelay {
+			delay = doubled
+		}
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Service manages customers' webhook subscriptions and sends order events to them.
+type Service struct {
+	repo   Repository
+	encode Encoder
+	policy RetryPolicy
+	client *http.Client
+	logger logging.Logger
+	// wake tells Run that deliveries have been made due, so that they are sent straight away
+	// rather than at the next poll.
+	wake chan struct{}
+}
+
+var _ orders.EventPublisher = (*Service)(nil)
+
+func NewService(repo Repository, encode Encoder, policy RetryPolicy, logger logging.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		encode: encode,
+		policy: policy,
+		client: newDeliveryClient(isPublicAddress),
+		logger: logger,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Subscribe creates a subscription that sends the customer's order events to the URL.
+func (s *Service) Subscribe(ctx context.Context, tenantID, customerID, url string) (Subscription, error) {
+	if err := validateSubscriptionURL(url); err != nil {
+		return Subscription{}, err
+	}
+
+	subs, err := s.repo.ListSubscriptions(ctx, tenantID, customerID)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	if len(subs) >= maxSubscriptions {
+		msg := fmt.Sprintf("a customer can have at most %d webhooks", maxSubscriptions)
+		return Subscription{}, terrors.Presentable(terrors.NewInvalidSingleInput("url", msg, nil))
+	}
+
+	sub, err := NewSubscription(tenantID, customerID, url)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("creating webhook secret: %w", err)
+	}
+
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		return Subscription{}, err
+	}
+
+	return sub, nil
+}
+
+func (s *Service) Subscriptions(ctx context.Context, tenantID, customerID string) ([]Subscription, error) {
+	return s.repo.ListSubscriptions(ctx, tenantID, customerID)
+}
+
+// Unsubscribe deletes one of the customer's subscriptions. Its deliveries are deleted along with
+// it, so any that are pending are not sent.
+func (s *Service) Unsubscribe(ctx context.Context, tenantID, customerID, subscriptionID string) error {
+	return s.repo.DeleteSubscription(ctx, tenantID, customerID, subscriptionID)
+}
+
+// Deliveries returns the most recent deliveries to one of the customer's subscriptions, newest
+// first.
+func (s *Service) Deliveries(ctx context.Context, tenantID, customerID, subscriptionID string) ([]Delivery, error) {
+	if _, err := s.repo.GetSubscription(ctx, tenantID, customerID, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListDeliveries(ctx, subscriptionID, deliveryLogLimit)
+}
+
+// Replay sends the dead deliveries of one of the customer's subscriptions again, with as many
+// attempts as a new delivery gets. It returns the deliveries that will be sent.
+func (s *Service) Replay(ctx context.Context, tenantID, customerID, subscriptionID string) ([]Delivery, error) {
+	if _, err := s.repo.GetSubscription(ctx, tenantID, customerID, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	replayed, err := s.repo.ReplayDeliveries(ctx, subscriptionID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify()
+	return replayed, nil
+}
+
+// Publish stores a delivery of the event for each of the customer's subscriptions. The deliveries
+// are sent by Run rather than here, so publishing does not wait for any receiver.
+func (s *Service) Publish(ctx context.Context, event orders.Event) error {
+	subs, err := s.repo.ListSubscriptions(ctx, event.TenantID, event.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	if len(subs) == 0 {
+		return nil
+	}
+
+	eventID := uuid.NewString()
+	payload, err := s.encode(eventID, event)
+	if err != nil {
+		return fmt.Errorf("encoding webhook payload: %w", err)
+	}
+
+	now := time.Now()
+	deliveries := make([]Delivery, 0, len(subs))
+	for _, sub := range subs {
+		deliveries = append(deliveries, Delivery{
+			ID:             uuid.NewString(),
+			SubscriptionID: sub.ID,
+			TenantID:       sub.TenantID,
+			CustomerID:     sub.CustomerID,
+			EventID:        eventID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         DeliveryStatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+
+	if err := s.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+
+	s.notify()
+	return nil
+}
+
+// Run sends deliveries as they fall due, until the context is cancelled. A delivery that is being
+// sent when the context is cancelled is left to be sent again once its lease runs out.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.sendDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// notify wakes Run up if it is waiting, without blocking if it has already been woken.
+func (s *Service) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// sendDue sends every delivery that is due, a batch at a time.
+func (s *Service) sendDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		due, err := s.repo.ClaimDueDeliveries(ctx, now, now.Add(deliveryLease), claimBatchSize)
+		if err != nil {
+			s.logger.WithError(err).Error("failed to claim due webhook deliveries")
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range due {
+			wg.Add(1)
+			go func(delivery Delivery) {
+				defer wg.Done()
+				s.send(ctx, delivery)
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(due) < claimBatchSize {
+			return
+		}
+	}
+}
+
+// send makes an attempt at a delivery and records how it went, dead-lettering the delivery if it
+// has used up its attempts.
+func (s *Service) send(ctx context.Context, delivery Delivery) {
+	logger := s.logger.WithFields(logging.Fields{
+		"delivery-id":     delivery.ID,
+		"subscription-id": delivery.SubscriptionID,
+	})
+
+	sub, err := s.repo.GetSubscription(ctx, delivery.TenantID, delivery.CustomerID, delivery.SubscriptionID)
+	notFoundErr := &terrors.NotFound{}
+	if errors.As(err, &notFoundErr) {
+		// The subscription was deleted after the delivery was claimed, taking the delivery with it.
+		return
+	}
+
+	if err != nil {
+		logger.WithError(err).Error("failed to get webhook subscription")
+		return
+	}
+
+	statusCode, sendErr := s.post(ctx, sub, delivery)
+	if ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = now
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = DeliveryStatusSucceeded
+		delivery.LastError = ""
+	case delivery.Attempts >= s.policy.MaxAttempts:
+		delivery.Status = DeliveryStatusDead
+		delivery.LastError = sendErr.Error()
+		logger.WithError(sendErr).WithField("attempts", delivery.Attempts).Warn("webhook delivery dead-lettered")
+	default:
+		delivery.NextAttemptAt = now.Add(s.policy.backoff(delivery.Attempts))
+		delivery.LastError = sendErr.Error()
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.WithError(err).Error("failed to record webhook delivery attempt")
+	}
+}
+
+// post sends a delivery's payload to the subscription's URL, returning the status of the response
+// if there was one. Any response other than a 2xx is an error.
+func (s *Service) post(ctx context.Context, sub Subscription, delivery Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventTypeHeader, string(delivery.EventType))
+	req.Header.Set(deliveryIDHeader, delivery.ID)
+	req.Header.Set(timestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(signatureHeader, "sha256="+hex.EncodeToString(sign(sub.Secret, timestamp, delivery.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// The body is read so that the connection can be reused, but only up to a limit, as nothing
+	// is done with it.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
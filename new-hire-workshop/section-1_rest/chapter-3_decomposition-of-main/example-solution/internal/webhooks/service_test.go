@@ -0,0 +1,375 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// receiver is a webhook receiver that responds to each delivery with the next of its statuses,
+// repeating the last one once they run out.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("reading delivery: %v", err)
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.requests = append(r.requests, receivedRequest{header: req.Header, body: body})
+		status := r.statuses[0]
+		if len(r.statuses) > 1 {
+			r.statuses = r.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func TestDeliveriesAreSigned(t *testing.T) {
+	receiver := newReceiver(t, http.StatusOK)
+	service, store := newTestService(RetryPolicy{MaxAttempts: 1, BaseDelay: time.Second, MaxDelay: time.Second})
+	sub := subscribe(t, store, receiver.URL)
+
+	publish(t, service)
+	service.sendDue(context.Background())
+
+	requests := receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d deliveries, want 1", len(requests))
+	}
+
+	header := requests[0].header
+	if got := header.Get(eventTypeHeader); got != string(orders.EventTypeOrderPlaced) {
+		t.Errorf("delivery has event type %q, want %q", got, orders.EventTypeOrderPlaced)
+	}
+
+	timestamp, err := strconv.ParseInt(header.Get(timestampHeader), 10, 64)
+	if err != nil {
+		t.Fatalf("delivery has invalid timestamp %q: %v", header.Get(timestampHeader), err)
+	}
+
+	// The signature is checked the way a receiver would, from the secret it was given.
+	signature, err := hex.DecodeString(strings.TrimPrefix(header.Get(signatureHeader), "sha256="))
+	if err != nil {
+		t.Fatalf("delivery has invalid signature %q: %v", header.Get(signatureHeader), err)
+	}
+	if want := sign(sub.Secret, time.Unix(timestamp, 0), requests[0].body); !hmac.Equal(signature, want) {
+		t.Errorf("delivery has signature %x, want %x", signature, want)
+	}
+	if want := sign("another secret", time.Unix(timestamp, 0), requests[0].body); hmac.Equal(signature, want) {
+		t.Error("delivery signature matches a different secret")
+	}
+
+	delivery := onlyDelivery(t, store, sub)
+	if delivery.Status != DeliveryStatusSucceeded || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusOK {
+		t.Errorf("delivery is %s after %d attempts with status %d, want it to have succeeded first time", delivery.Status, delivery.Attempts, delivery.LastStatusCode)
+	}
+	if header.Get(deliveryIDHeader) != delivery.ID {
+		t.Errorf("delivery was sent with ID %q, want %q", header.Get(deliveryIDHeader), delivery.ID)
+	}
+}
+
+func TestFailedDeliveriesAreRetried(t *testing.T) {
+	receiver := newReceiver(t, http.StatusInternalServerError, http.StatusOK)
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: 50 * time.Millisecond, MaxDelay: time.Second}
+	service, store := newTestService(policy)
+	sub := subscribe(t, store, receiver.URL)
+
+	publish(t, service)
+	failedAt := time.Now()
+	service.sendDue(context.Background())
+
+	delivery := onlyDelivery(t, store, sub)
+	if delivery.Status != DeliveryStatusPending || delivery.Attempts != 1 || delivery.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("delivery is %s after %d attempts with status %d, want it pending after 1 failed attempt", delivery.Status, delivery.Attempts, delivery.LastStatusCode)
+	}
+	if wait := delivery.NextAttemptAt.Sub(failedAt); wait < policy.BaseDelay/2 || wait > policy.BaseDelay+time.Second {
+		t.Errorf("delivery is retried after %s, want around %s", wait, policy.BaseDelay)
+	}
+
+	// The retry is not sent until it is due.
+	service.sendDue(context.Background())
+	if got := len(receiver.received()); got != 1 {
+		t.Fatalf("receiver got %d deliveries before the retry was due, want 1", got)
+	}
+
+	time.Sleep(time.Until(delivery.NextAttemptAt))
+	service.sendDue(context.Background())
+
+	delivery = onlyDelivery(t, store, sub)
+	if delivery.Status != DeliveryStatusSucceeded || delivery.Attempts != 2 || delivery.LastError != "" {
+		t.Errorf("delivery is %s after %d attempts with error %q, want it to have succeeded on the retry", delivery.Status, delivery.Attempts, delivery.LastError)
+	}
+}
+
+func TestDeliveriesAreDeadLetteredAfterTooManyFailures(t *testing.T) {
+	receiver := newReceiver(t, http.StatusServiceUnavailable)
+	service, store := newTestService(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+	sub := subscribe(t, store, receiver.URL)
+
+	publish(t, service)
+	for i := 0; i < 5; i++ {
+		service.sendDue(context.Background())
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	delivery := onlyDelivery(t, store, sub)
+	if delivery.Status != DeliveryStatusDead || delivery.Attempts != 2 || delivery.LastError == "" {
+		t.Fatalf("delivery is %s after %d attempts with error %q, want it dead after 2 attempts", delivery.Status, delivery.Attempts, delivery.LastError)
+	}
+	if got := len(receiver.received()); got != 2 {
+		t.Errorf("receiver got %d deliveries, want 2", got)
+	}
+
+	// Replaying the delivery gives it a fresh set of attempts.
+	replayed, err := service.Replay(context.Background(), sub.TenantID, sub.CustomerID, sub.ID)
+	if err != nil {
+		t.Fatalf("Replay returned error: %v", err)
+	}
+	if len(replayed) != 1 || replayed[0].Status != DeliveryStatusPending || replayed[0].Attempts != 0 {
+		t.Errorf("Replay returned %+v, want the delivery pending with no attempts", replayed)
+	}
+}
+
+func TestDeliveriesToInternalAddressesAreRefused(t *testing.T) {
+	receiver := newReceiver(t, http.StatusOK)
+	store := NewStore()
+	service := NewService(store, encodeTestEvent, RetryPolicy{MaxAttempts: 1, BaseDelay: time.Second, MaxDelay: time.Second}, logging.NewNoopLogger())
+
+	// The receiver is on a loopback address, which is only found out when a delivery is sent if
+	// the URL uses a host name, so the subscription is stored directly.
+	sub := subscribe(t, store, strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1))
+
+	publish(t, service)
+	service.sendDue(context.Background())
+
+	if got := len(receiver.received()); got != 0 {
+		t.Errorf("receiver on a loopback address got %d deliveries, want none", got)
+	}
+
+	delivery := onlyDelivery(t, store, sub)
+	if delivery.Status != DeliveryStatusDead || !strings.Contains(delivery.LastError, "not a public address") {
+		t.Errorf("delivery is %s with error %q, want it refused", delivery.Status, delivery.LastError)
+	}
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		public  bool
+	}{
+		{address: "93.184.216.34", public: true},
+		{address: "2606:2800:220:1:248:1893:25c8:1946", public: true},
+		{address: "127.0.0.1", public: false},
+		{address: "::1", public: false},
+		{address: "10.1.2.3", public: false},
+		{address: "172.16.0.1", public: false},
+		{address: "192.168.1.1", public: false},
+		{address: "fd00::1", public: false},
+		{address: "169.254.169.254", public: false},
+		{address: "fe80::1", public: false},
+		{address: "224.0.0.1", public: false},
+		{address: "ff02::1", public: false},
+		{address: "0.0.0.0", public: false},
+		{address: "0.1.2.3", public: false},
+		{address: "::", public: false},
+		{address: "100.64.0.1", public: false},
+		{address: "100.127.255.254", public: false},
+		{address: "64:ff9b::7f00:1", public: false},
+		{address: "64:ff9b::a9fe:a9fe", public: false},
+		{address: "::ffff:127.0.0.1", public: false},
+		{address: "::ffff:10.0.0.1", public: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			if got := isPublicAddress(net.ParseIP(tt.address)); got != tt.public {
+				t.Errorf("isPublicAddress(%s) = %t, want %t", tt.address, got, tt.public)
+			}
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://hooks.example.com/orders", valid: true},
+		{url: "http://203.0.113.10:8080/orders", valid: true},
+		{url: "http://localhost/orders"},
+		{url: "http://api.localhost/orders"},
+		{url: "http://127.0.0.1/orders"},
+		{url: "http://[::1]/orders"},
+		{url: "http://10.1.2.3/orders"},
+		{url: "http://172.16.0.1/orders"},
+		{url: "http://192.168.1.1/orders"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "http://[fe80::1]/orders"},
+		{url: "http://[::ffff:127.0.0.1]/orders"},
+		{url: "http://0.0.0.0/orders"},
+		{url: "http://100.64.0.1/orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			service, _ := newTestService(RetryPolicy{})
+
+			_, err := service.Subscribe(context.Background(), "tenant", "customer", tt.url)
+			if tt.valid && err != nil {
+				t.Errorf("Subscribe returned error: %v", err)
+			}
+			if !tt.valid && !errors.As(err, &terrors.InvalidInputType) {
+				t.Errorf("Subscribe returned error %v, want an InvalidInput error", err)
+			}
+		})
+	}
+}
+
+func TestSubscribeLimitsSubscriptionsPerCustomer(t *testing.T) {
+	service, _ := newTestService(RetryPolicy{})
+	ctx := context.Background()
+
+	for i := 0; i < maxSubscriptions; i++ {
+		if _, err := service.Subscribe(ctx, "tenant", "customer", "https://hooks.example.com/orders"); err != nil {
+			t.Fatalf("Subscribe %d returned error: %v", i+1, err)
+		}
+	}
+
+	_, err := service.Subscribe(ctx, "tenant", "customer", "https://hooks.example.com/orders")
+	if !errors.As(err, &terrors.InvalidInputType) {
+		t.Errorf("Subscribe over the limit returned error %v, want an InvalidInput error", err)
+	}
+
+	// The limit is per customer.
+	if _, err := service.Subscribe(ctx, "tenant", "another customer", "https://hooks.example.com/orders"); err != nil {
+		t.Errorf("Subscribe for another customer returned error: %v", err)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 100, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if got := policy.backoff(tt.attempts); got < tt.want/2 || got > tt.want {
+				t.Errorf("backoff after %d attempts is %s, want between %s and %s", tt.attempts, got, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+// newTestService creates a service whose deliveries can be sent to receivers on loopback
+// addresses, which is where httptest servers listen.
+func newTestService(policy RetryPolicy) (*Service, *Store) {
+	store := NewStore()
+	service := NewService(store, encodeTestEvent, policy, logging.NewNoopLogger())
+	service.client = newDeliveryClient(func(ip net.IP) bool {
+		return ip.IsLoopback() || isPublicAddress(ip)
+	})
+
+	return service, store
+}
+
+func encodeTestEvent(eventID string, event orders.Event) ([]byte, error) {
+	return []byte(`{"id": "` + eventID + `", "orderId": "` + event.OrderID + `"}`), nil
+}
+
+// subscribe stores a subscription for the test customer, without the checks that Subscribe makes
+// of its URL.
+func subscribe(t *testing.T, store *Store, url string) Subscription {
+	t.Helper()
+
+	sub, err := NewSubscription("tenant", "customer", url)
+	if err != nil {
+		t.Fatalf("NewSubscription returned error: %v", err)
+	}
+
+	if err := store.CreateSubscription(context.Background(), sub); err != nil {
+		t.Fatalf("CreateSubscription returned error: %v", err)
+	}
+
+	return sub
+}
+
+func publish(t *testing.T, service *Service) {
+	t.Helper()
+
+	err := service.Publish(context.Background(), orders.Event{
+		Type:       orders.EventTypeOrderPlaced,
+		TenantID:   "tenant",
+		OrderID:    "order",
+		CustomerID: "customer",
+		Status:     orders.OrderStatusPlaced,
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+}
+
+func onlyDelivery(t *testing.T, store *Store, sub Subscription) Delivery {
+	t.Helper()
+
+	deliveries, err := store.ListDeliveries(context.Background(), sub.ID, deliveryLogLimit)
+	if err != nil {
+		t.Fatalf("ListDeliveries returned error: %v", err)
+	}
+
+	if len(deliveries) != 1 {
+		t.Fatalf("subscription has %d deliveries, want 1", len(deliveries))
+	}
+
+	return deliveries[0]
+}
@@ -0,0 +1,28 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"strconv"
+	"time"
+)
+
+// The headers sent with each delivery, besides the Content-Type.
+const (
+	eventTypeHeader  = "Webhook-Event"
+	deliveryIDHeader = "Webhook-Delivery"
+	// timestampHeader is when the delivery was sent, in seconds since the Unix epoch. It is part
+	// of the signature, so that a receiver can reject old deliveries that are sent to it again.
+	timestampHeader = "Webhook-Timestamp"
+	// signatureHeader is "sha256=" followed by the signature from sign, in hex.
+	signatureHeader = "Webhook-Signature"
+)
+
+// sign returns the HMAC-SHA256 of a delivery's timestamp and payload, keyed with the secret.
+func sign(secret string, timestamp time.Time, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,188 @@
+package webhooks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.hpe.com/cloud/go-gadgets/x/terrors"
+)
+
+// Store is an in-memory implementation of Repository. It is safe for concurrent use by multiple
+// goroutines.
+type Store struct {
+	mu            sync.Mutex
+	subscriptions map[string]Subscription
+	deliveries    map[string]Delivery
+}
+
+var _ Repository = (*Store)(nil)
+
+func NewStore() *Store {
+	return &Store{
+		subscriptions: map[string]Subscription{},
+		deliveries:    map[string]Delivery{},
+	}
+}
+
+func (s *Store) CreateSubscription(_ context.Context, sub Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subscriptions[sub.ID] = sub
+	return nil
+}
+
+func (s *Store) ListSubscriptions(_ context.Context, tenantID, customerID string) ([]Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subs := []Subscription{}
+	for _, sub := range s.subscriptions {
+		if sub.TenantID == tenantID && sub.CustomerID == customerID {
+			subs = append(subs, sub)
+		}
+	}
+
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].CreatedAt.Before(subs[j].CreatedAt)
+	})
+
+	return subs, nil
+}
+
+func (s *Store) GetSubscription(_ context.Context, tenantID, customerID, subscriptionID string) (Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subscriptions[subscriptionID]
+	if !ok || sub.TenantID != tenantID || sub.CustomerID != customerID {
+		return Subscription{}, terrors.Presentable(terrors.NewNotFound("webhook", "id", subscriptionID, nil))
+	}
+
+	return sub, nil
+}
+
+func (s *Store) DeleteSubscription(_ context.Context, tenantID, customerID, subscriptionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subscriptions[subscriptionID]
+	if !ok || sub.TenantID != tenantID || sub.CustomerID != customerID {
+		return terrors.Presentable(terrors.NewNotFound("webhook", "id", subscriptionID, nil))
+	}
+
+	delete(s.subscriptions, subscriptionID)
+	for id, delivery := range s.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			delete(s.deliveries, id)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) CreateDeliveries(_ context.Context, deliveries []Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		s.deliveries[delivery.ID] = delivery.clone()
+	}
+
+	return nil
+}
+
+func (s *Store) ListDeliveries(_ context.Context, subscriptionID string, limit int) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deliveries := []Delivery{}
+	for _, delivery := range s.deliveries {
+		if delivery.SubscriptionID == subscriptionID {
+			deliveries = append(deliveries, delivery.clone())
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+
+	return deliveries, nil
+}
+
+func (s *Store) ClaimDueDeliveries(_ context.Context, now, leaseUntil time.Time, limit int) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []Delivery
+	for _, delivery := range s.deliveries {
+		if delivery.Status == DeliveryStatusPending && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	for i := range due {
+		due[i].NextAttemptAt = leaseUntil
+		s.deliveries[due[i].ID] = due[i].clone()
+	}
+
+	return due, nil
+}
+
+func (s *Store) UpdateDelivery(_ context.Context, delivery Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.deliveries[delivery.ID]; !ok {
+		return terrors.NewNotFound("webhook delivery", "id", delivery.ID, nil)
+	}
+
+	s.deliveries[delivery.ID] = delivery.clone()
+	return nil
+}
+
+func (s *Store) ReplayDeliveries(_ context.Context, subscriptionID string, at time.Time) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replayed := []Delivery{}
+	for id, delivery := range s.deliveries {
+		if delivery.SubscriptionID != subscriptionID || delivery.Status != DeliveryStatusDead {
+			continue
+		}
+
+		delivery.Status = DeliveryStatusPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = at
+		delivery.UpdatedAt = at
+		s.deliveries[id] = delivery
+		replayed = append(replayed, delivery.clone())
+	}
+
+	sort.Slice(replayed, func(i, j int) bool {
+		return replayed[i].CreatedAt.After(replayed[j].CreatedAt)
+	})
+
+	return replayed, nil
+}
+
+// clone copies the payload, so that a caller changing a delivery it was given cannot change what
+// is held in the store.
+func (d Delivery) clone() Delivery {
+	d.Payload = append([]byte(nil), d.Payload...)
+	return d
+}
@@ -0,0 +1,116 @@
+// Package webhooks sends order events to the URLs that customers have subscribed.
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+)
+
+// Subscription is a URL that a customer wants their order events sent to.
+type Subscription struct {
+	ID string
+	// TenantID is the tenant of the customer, whose order events are the only ones sent to the
+	// subscription.
+	TenantID   string
+	CustomerID string
+	URL        string
+	// Secret is the key that the deliveries to the subscription are signed with, so that the
+	// receiver can check they came from this service.
+	Secret    string
+	CreatedAt time.Time
+}
+
+// NewSubscription creates a subscription with a generated ID and secret.
+func NewSubscription(tenantID, customerID, url string) (Subscription, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Subscription{}, err
+	}
+
+	return Subscription{
+		ID:         uuid.NewString(),
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		URL:        url,
+		Secret:     "whsec_" + hex.EncodeToString(secret),
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending is a delivery that has not succeeded yet, but will be tried again.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusSucceeded is a delivery that the receiver accepted.
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	// DeliveryStatusDead is a delivery that failed too many times to be tried again on its own.
+	// It is only sent again if it is replayed.
+	DeliveryStatusDead DeliveryStatus = "DEAD"
+)
+
+// Delivery is an event to be sent to a subscription, along with how the attempts to send it have
+// gone. The deliveries of a subscription make up its delivery log.
+type Delivery struct {
+	ID             string
+	SubscriptionID string
+	TenantID       string
+	CustomerID     string
+	EventID        string
+	EventType      orders.EventType
+	// Payload is the request body sent to the subscription's URL.
+	Payload []byte
+	Status  DeliveryStatus
+	// Attempts counts the attempts to send the delivery since it was created or last replayed.
+	Attempts int
+	// NextAttemptAt is when a pending delivery is next due to be sent.
+	NextAttemptAt time.Time
+	// LastStatusCode is the status of the receiver's response to the last attempt, or 0 if the
+	// attempt did not get a response.
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Repository is the access point for reading and writing subscriptions and their deliveries. A
+// subscription that belongs to another tenant is treated as if it did not exist.
+type Repository interface {
+	// CreateSubscription stores a new subscription.
+	CreateSubscription(ctx context.Context, sub Subscription) error
+
+	// ListSubscriptions returns the customer's subscriptions, oldest first.
+	ListSubscriptions(ctx context.Context, tenantID, customerID string) ([]Subscription, error)
+
+	// GetSubscription returns the customer's subscription with the specified ID, or a
+	// terrors.NotFound error if the customer has no such subscription.
+	GetSubscription(ctx context.Context, tenantID, customerID, subscriptionID string) (Subscription, error)
+
+	// DeleteSubscription deletes the customer's subscription along with its deliveries, returning
+	// a terrors.NotFound error if the customer has no such subscription.
+	DeleteSubscription(ctx context.Context, tenantID, customerID, subscriptionID string) error
+
+	// CreateDeliveries stores new deliveries.
+	CreateDeliveries(ctx context.Context, deliveries []Delivery) error
+
+	// ListDeliveries returns up to limit of the subscription's deliveries, newest first.
+	ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]Delivery, error)
+
+	// ClaimDueDeliveries returns up to limit pending deliveries that are due by now, moving each
+	// one's next attempt to leaseUntil. Deliveries are only claimed by one caller at a time, so
+	// that two servers sharing a database do not both send them.
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Delivery, error)
+
+	// UpdateDelivery replaces a stored delivery with the one provided, returning a
+	// terrors.NotFound error if the delivery does not exist.
+	UpdateDelivery(ctx context.Context, delivery Delivery) error
+
+	// ReplayDeliveries makes the subscription's dead deliveries pending again, due at the given
+	// time and with no attempts, and returns them.
+	ReplayDeliveries(ctx context.Context, subscriptionID string, at time.Time) ([]Delivery, error)
+}
@@ -0,0 +1,5030 @@
+// Package ordersclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.13.4 DO NOT EDIT.
+package ordersclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for OrderStatus.
+const (
+	OrderStatusDelivered OrderStatus = "Delivered"
+	OrderStatusInTransit OrderStatus = "In transit"
+	OrderStatusPlaced    OrderStatus = "Placed"
+)
+
+// Defines values for OrderStatusV2.
+const (
+	OrderStatusV2Cancelled OrderStatusV2 = "Cancelled"
+	OrderStatusV2Delivered OrderStatusV2 = "Delivered"
+	OrderStatusV2InTransit OrderStatusV2 = "In transit"
+	OrderStatusV2OnHold    OrderStatusV2 = "On hold"
+	OrderStatusV2Placed    OrderStatusV2 = "Placed"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "Dead"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "Succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeOrderCancelled     WebhookEventType = "order.cancelled"
+	WebhookEventTypeOrderPlaced        WebhookEventType = "order.placed"
+	WebhookEventTypeOrderStatusChanged WebhookEventType = "order.status_changed"
+)
+
+// Defines values for Sort.
+const (
+	SortMinusOrderedAt Sort = "-orderedAt"
+	SortOrderedAt      Sort = "orderedAt"
+)
+
+// Defines values for ListOrdersParamsSort.
+const (
+	ListOrdersParamsSortMinusOrderedAt ListOrdersParamsSort = "-orderedAt"
+	ListOrdersParamsSortOrderedAt      ListOrdersParamsSort = "orderedAt"
+)
+
+// Defines values for ListOrdersV2ParamsSort.
+const (
+	MinusOrderedAt ListOrdersV2ParamsSort = "-orderedAt"
+	OrderedAt      ListOrdersV2ParamsSort = "orderedAt"
+)
+
+// CancelOrderRequest defines model for CancelOrderRequest.
+type CancelOrderRequest struct {
+	// Reason Why the customer is cancelling the order
+	Reason *string `json:"reason,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Url The http or https URL to send the events to
+	Url string `json:"url"`
+}
+
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Secret The key that the requests sent to the webhook are signed with
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// DeliveryEntry defines model for DeliveryEntry.
+type DeliveryEntry struct {
+	// Message Description of event
+	Message string `json:"message"`
+
+	// Timestamp When the event occurred
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Message The error message
+	Message string `json:"message"`
+}
+
+// HoldOrderRequest defines model for HoldOrderRequest.
+type HoldOrderRequest struct {
+	// Reason Why the order is being held, which the customer is shown
+	Reason *string `json:"reason,omitempty"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// Name The name of the rejected input
+	Name string `json:"name"`
+
+	// Reason Why the input was rejected
+	Reason string `json:"reason"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// DeliveredAt When the order was delivered to the delivery address
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	// DeliveryEntries A list of the delivery events that have occured so far for the order
+	DeliveryEntries []DeliveryEntry `json:"deliveryEntries"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// OrderedAt When the order was placed
+	OrderedAt time.Time `json:"orderedAt"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order
+	Status OrderStatus `json:"status"`
+}
+
+// OrderStatus Summary of the current status of the order
+type OrderStatus string
+
+// OrderStatusEvent defines model for OrderStatusEvent.
+type OrderStatusEvent struct {
+	// Reason The reason given for the status, such as why the order was cancelled
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+}
+
+// OrderStatusV2 Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+// are reported as such rather than as placed.
+type OrderStatusV2 string
+
+// OrderSummary defines model for OrderSummary.
+type OrderSummary struct {
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order
+	Status OrderStatus `json:"status"`
+}
+
+// OrderSummaryList defines model for OrderSummaryList.
+type OrderSummaryList struct {
+	// NextPageToken Pass as pageToken to fetch the next page. Omitted on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// OrderSummaries Array of the order summaries
+	OrderSummaries []OrderSummary `json:"orderSummaries"`
+}
+
+// OrderSummaryListV2 defines model for OrderSummaryListV2.
+type OrderSummaryListV2 struct {
+	// NextPageToken Pass as pageToken to fetch the next page. Omitted on the last page.
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+
+	// OrderSummaries Array of the order summaries
+	OrderSummaries []OrderSummaryV2 `json:"orderSummaries"`
+}
+
+// OrderSummaryV2 defines model for OrderSummaryV2.
+type OrderSummaryV2 struct {
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+
+	// StatusReason Why the order moved into its current status, if a reason was given
+	StatusReason *string `json:"statusReason,omitempty"`
+}
+
+// OrderV2 defines model for OrderV2.
+type OrderV2 struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// CustomerId The ID of the customer that placed the order
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// DeliveredAt When the order was delivered to the delivery address
+	DeliveredAt *time.Time `json:"deliveredAt"`
+
+	// DeliveryEntries A list of the delivery events that have occured so far for the order
+	DeliveryEntries []DeliveryEntry `json:"deliveryEntries"`
+
+	// OrderId The ID of the order
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// OrderedAt When the order was placed
+	OrderedAt time.Time `json:"orderedAt"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product that has been ordered
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+
+	// StatusReason Why the order moved into its current status, if a reason was given
+	StatusReason *string `json:"statusReason,omitempty"`
+}
+
+// PlaceOrderRequest defines model for PlaceOrderRequest.
+type PlaceOrderRequest struct {
+	// Address The delivery address for the order
+	Address string `json:"address"`
+
+	// PaymentId The ID of the transaction that paid for the order
+	PaymentId openapi_types.UUID `json:"paymentId"`
+
+	// ProductId The ID of the product to order
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+// Problem An RFC 7807 problem details error. It is returned instead of Error when the client accepts
+// application/problem+json, and by default from /api/v2.
+type Problem struct {
+	// Detail An explanation of this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance The path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams The inputs that were rejected, when the request was invalid
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+
+	// Status The HTTP status code of the response
+	Status int `json:"status"`
+
+	// Title A short summary of the problem type
+	Title string `json:"title"`
+
+	// Type A URI reference that identifies the problem type
+	Type string `json:"type"`
+}
+
+// SetOrderStatusRequest defines model for SetOrderStatusRequest.
+type SetOrderStatusRequest struct {
+	// Reason Why the order's status is being changed, which the customer is shown
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2 `json:"status"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Url       string             `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts The attempts made since the delivery was created or last replayed
+	Attempts  int                `json:"attempts"`
+	CreatedAt time.Time          `json:"createdAt"`
+	EventId   openapi_types.UUID `json:"eventId"`
+	EventType WebhookEventType   `json:"eventType"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError Why the last attempt failed
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode The status the webhook responded to the last attempt with
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt When a pending delivery will next be tried
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Status Whether the delivery is still being tried, was accepted by the webhook, or failed too many
+	// times and was dead-lettered.
+	Status    WebhookDeliveryStatus `json:"status"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+}
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookDeliveryStatus Whether the delivery is still being tried, was accepted by the webhook, or failed too many
+// times and was dead-lettered.
+type WebhookDeliveryStatus string
+
+// WebhookEvent The body of the requests sent to webhooks.
+type WebhookEvent struct {
+	CustomerId openapi_types.UUID `json:"customerId"`
+
+	// Id The ID of the event, which is the same for every webhook it is sent to
+	Id         openapi_types.UUID `json:"id"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	OrderId    openapi_types.UUID `json:"orderId"`
+
+	// Reason The reason given for the status, such as why the order was cancelled
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Summary of the current status of the order. Unlike OrderStatus, cancelled and held orders
+	// are reported as such rather than as placed.
+	Status OrderStatusV2    `json:"status"`
+	Type   WebhookEventType `json:"type"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// CustomerID defines model for CustomerID.
+type CustomerID = openapi_types.UUID
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// LastEventID defines model for LastEventID.
+type LastEventID = int
+
+// Limit defines model for Limit.
+type Limit = int
+
+// OrderedBefore defines model for OrderedBefore.
+type OrderedBefore = time.Time
+
+// OrderedFrom defines model for OrderedFrom.
+type OrderedFrom = time.Time
+
+// PageToken defines model for PageToken.
+type PageToken = string
+
+// RequestID defines model for RequestID.
+type RequestID = string
+
+// Sort defines model for Sort.
+type Sort string
+
+// TenantID defines model for TenantID.
+type TenantID = string
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// ListOrdersParams defines parameters for ListOrders.
+type ListOrdersParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// CustomerId The customer whose orders to list, if it is not the user making the request. Only support
+	// staff and admins may list other customers' orders.
+	CustomerId *CustomerID `form:"customerId,omitempty" json:"customerId,omitempty"`
+
+	// Limit The maximum number of order summaries to return in one page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PageToken The nextPageToken returned by a previous call. The other list parameters must be the same as
+	// in the call that returned the token.
+	PageToken *PageToken `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// Status Only return orders in one of the given statuses. May be repeated.
+	Status *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrderedFrom Only return orders placed at or after this time
+	OrderedFrom *OrderedFrom `form:"orderedFrom,omitempty" json:"orderedFrom,omitempty"`
+
+	// OrderedBefore Only return orders placed before this time
+	OrderedBefore *OrderedBefore `form:"orderedBefore,omitempty" json:"orderedBefore,omitempty"`
+
+	// Sort Sort by order time, oldest first, or newest first when prefixed with '-'
+	Sort *ListOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListOrdersParamsSort defines parameters for ListOrders.
+type ListOrdersParamsSort string
+
+// PlaceOrderParams defines parameters for PlaceOrder.
+type PlaceOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IdempotencyKey A unique key chosen by the client, such as a UUID, so that the request can be safely
+	// retried. A retry with the same key and body gets the response to the first request, with
+	// an Idempotent-Replayed header, rather than placing another order. Keys are scoped to the
+	// user and are kept for 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetOrderParams defines parameters for GetOrder.
+type GetOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetOrderEventsParams defines parameters for GetOrderEvents.
+type GetOrderEventsParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// LastEventID The ID of the last event the client received from an earlier stream, so that the stream
+	// can carry on from there.
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// CancelOrderParams defines parameters for CancelOrder.
+type CancelOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ReplayWebhookDeliveriesParams defines parameters for ReplayWebhookDeliveries.
+type ReplayWebhookDeliveriesParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+}
+
+// ListOrdersV2Params defines parameters for ListOrdersV2.
+type ListOrdersV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// CustomerId The customer whose orders to list, if it is not the user making the request. Only support
+	// staff and admins may list other customers' orders.
+	CustomerId *CustomerID `form:"customerId,omitempty" json:"customerId,omitempty"`
+
+	// Limit The maximum number of order summaries to return in one page
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// PageToken The nextPageToken returned by a previous call. The other list parameters must be the same as
+	// in the call that returned the token.
+	PageToken *PageToken `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+
+	// Status Only return orders in one of the given statuses. May be repeated.
+	Status *[]OrderStatusV2 `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrderedFrom Only return orders placed at or after this time
+	OrderedFrom *OrderedFrom `form:"orderedFrom,omitempty" json:"orderedFrom,omitempty"`
+
+	// OrderedBefore Only return orders placed before this time
+	OrderedBefore *OrderedBefore `form:"orderedBefore,omitempty" json:"orderedBefore,omitempty"`
+
+	// Sort Sort by order time, oldest first, or newest first when prefixed with '-'
+	Sort *ListOrdersV2ParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListOrdersV2ParamsSort defines parameters for ListOrdersV2.
+type ListOrdersV2ParamsSort string
+
+// PlaceOrderV2Params defines parameters for PlaceOrderV2.
+type PlaceOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IdempotencyKey A unique key chosen by the client, such as a UUID, so that the request can be safely
+	// retried. A retry with the same key and body gets the response to the first request, with
+	// an Idempotent-Replayed header, rather than placing another order. Keys are scoped to the
+	// user and are kept for 24 hours by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetOrderV2Params defines parameters for GetOrderV2.
+type GetOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfNoneMatch The ETags of responses the client already has. If the response still has one of them, a 304
+	// with no body is returned instead.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// CancelOrderV2Params defines parameters for CancelOrderV2.
+type CancelOrderV2Params struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// HoldOrderParams defines parameters for HoldOrder.
+type HoldOrderParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SetOrderStatusParams defines parameters for SetOrderStatus.
+type SetOrderStatusParams struct {
+	// Userid The ID of the user making the request. It is only used by servers started in development
+	// mode, which have no identity provider to issue tokens; other servers take the user from
+	// the bearer token and ignore this parameter.
+	Userid *UserID `form:"userid,omitempty" json:"userid,omitempty"`
+
+	// XTenantID The tenant the request is for. Servers started in development mode serve the request from
+	// this tenant's data, or from the default tenant if it is not given. Other servers take the
+	// tenant from the bearer token, and reject the request with a 403 if this header names a
+	// different one, as a request can never reach another tenant's data.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// XRequestID An ID for the request, which is logged with everything the server logs while handling it
+	// so that the request can be traced. The server makes up an ID if it is not given, or is not
+	// 1 to 128 letters, digits or any of ._:- characters. The ID is sent back in the X-Request-ID
+	// header of every response.
+	XRequestID *RequestID `json:"X-Request-ID,omitempty"`
+
+	// IfMatch The ETags of the versions of the order that the change may be made to. If the order has
+	// changed since, a 412 is returned and the order is left as it is.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PlaceOrderJSONRequestBody defines body for PlaceOrder for application/json ContentType.
+type PlaceOrderJSONRequestBody = PlaceOrderRequest
+
+// CancelOrderJSONRequestBody defines body for CancelOrder for application/json ContentType.
+type CancelOrderJSONRequestBody = CancelOrderRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// PlaceOrderV2JSONRequestBody defines body for PlaceOrderV2 for application/json ContentType.
+type PlaceOrderV2JSONRequestBody = PlaceOrderRequest
+
+// CancelOrderV2JSONRequestBody defines body for CancelOrderV2 for application/json ContentType.
+type CancelOrderV2JSONRequestBody = CancelOrderRequest
+
+// HoldOrderJSONRequestBody defines body for HoldOrder for application/json ContentType.
+type HoldOrderJSONRequestBody = HoldOrderRequest
+
+// SetOrderStatusJSONRequestBody defines body for SetOrderStatus for application/json ContentType.
+type SetOrderStatusJSONRequestBody = SetOrderStatusRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RawClient which conforms to the OpenAPI3 specification for this service.
+type RawClient struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*RawClient) error
+
+// Creates a new RawClient, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*RawClient, error) {
+	// create a client with sane default values
+	client := RawClient{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *RawClient) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *RawClient) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListOrders request
+	ListOrders(ctx context.Context, params *ListOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PlaceOrderWithBody request with any body
+	PlaceOrderWithBody(ctx context.Context, params *PlaceOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PlaceOrder(ctx context.Context, params *PlaceOrderParams, body PlaceOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrder request
+	GetOrder(ctx context.Context, orderid openapi_types.UUID, params *GetOrderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrderEvents request
+	GetOrderEvents(ctx context.Context, orderid openapi_types.UUID, params *GetOrderEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelOrderWithBody request with any body
+	CancelOrderWithBody(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelOrder(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, body CancelOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhooks request
+	ListWebhooks(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, webhookid openapi_types.UUID, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, webhookid openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayWebhookDeliveries request
+	ReplayWebhookDeliveries(ctx context.Context, webhookid openapi_types.UUID, params *ReplayWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrdersV2 request
+	ListOrdersV2(ctx context.Context, params *ListOrdersV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PlaceOrderV2WithBody request with any body
+	PlaceOrderV2WithBody(ctx context.Context, params *PlaceOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PlaceOrderV2(ctx context.Context, params *PlaceOrderV2Params, body PlaceOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrderV2 request
+	GetOrderV2(ctx context.Context, orderid openapi_types.UUID, params *GetOrderV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelOrderV2WithBody request with any body
+	CancelOrderV2WithBody(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CancelOrderV2(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, body CancelOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HoldOrderWithBody request with any body
+	HoldOrderWithBody(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HoldOrder(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, body HoldOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetOrderStatusWithBody request with any body
+	SetOrderStatusWithBody(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetOrderStatus(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, body SetOrderStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *RawClient) ListOrders(ctx context.Context, params *ListOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrdersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) PlaceOrderWithBody(ctx context.Context, params *PlaceOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceOrderRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) PlaceOrder(ctx context.Context, params *PlaceOrderParams, body PlaceOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceOrderRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) GetOrder(ctx context.Context, orderid openapi_types.UUID, params *GetOrderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrderRequest(c.Server, orderid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) GetOrderEvents(ctx context.Context, orderid openapi_types.UUID, params *GetOrderEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrderEventsRequest(c.Server, orderid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CancelOrderWithBody(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelOrderRequestWithBody(c.Server, orderid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CancelOrder(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, body CancelOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelOrderRequest(c.Server, orderid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) ListWebhooks(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CreateWebhookWithBody(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CreateWebhook(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) DeleteWebhook(ctx context.Context, webhookid openapi_types.UUID, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, webhookid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) ListWebhookDeliveries(ctx context.Context, webhookid openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, webhookid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) ReplayWebhookDeliveries(ctx context.Context, webhookid openapi_types.UUID, params *ReplayWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayWebhookDeliveriesRequest(c.Server, webhookid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) ListOrdersV2(ctx context.Context, params *ListOrdersV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrdersV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) PlaceOrderV2WithBody(ctx context.Context, params *PlaceOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceOrderV2RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) PlaceOrderV2(ctx context.Context, params *PlaceOrderV2Params, body PlaceOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceOrderV2Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) GetOrderV2(ctx context.Context, orderid openapi_types.UUID, params *GetOrderV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrderV2Request(c.Server, orderid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CancelOrderV2WithBody(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelOrderV2RequestWithBody(c.Server, orderid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) CancelOrderV2(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, body CancelOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelOrderV2Request(c.Server, orderid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) HoldOrderWithBody(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHoldOrderRequestWithBody(c.Server, orderid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) HoldOrder(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, body HoldOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHoldOrderRequest(c.Server, orderid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) SetOrderStatusWithBody(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrderStatusRequestWithBody(c.Server, orderid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *RawClient) SetOrderStatus(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, body SetOrderStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrderStatusRequest(c.Server, orderid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListOrdersRequest generates requests for ListOrders
+func NewListOrdersRequest(server string, params *ListOrdersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CustomerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "customerId", runtime.ParamLocationQuery, *params.CustomerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderedFrom", runtime.ParamLocationQuery, *params.OrderedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderedBefore", runtime.ParamLocationQuery, *params.OrderedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPlaceOrderRequest calls the generic PlaceOrder builder with application/json body
+func NewPlaceOrderRequest(server string, params *PlaceOrderParams, body PlaceOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPlaceOrderRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPlaceOrderRequestWithBody generates requests for PlaceOrder with any type of body
+func NewPlaceOrderRequestWithBody(server string, params *PlaceOrderParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IdempotencyKey != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrderRequest generates requests for GetOrder
+func NewGetOrderRequest(server string, orderid openapi_types.UUID, params *GetOrderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrderEventsRequest generates requests for GetOrderEvents
+func NewGetOrderEventsRequest(server string, orderid openapi_types.UUID, params *GetOrderEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.LastEventID != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCancelOrderRequest calls the generic CancelOrder builder with application/json body
+func NewCancelOrderRequest(server string, orderid openapi_types.UUID, params *CancelOrderParams, body CancelOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelOrderRequestWithBody(server, orderid, params, "application/json", bodyReader)
+}
+
+// NewCancelOrderRequestWithBody generates requests for CancelOrder with any type of body
+func NewCancelOrderRequestWithBody(server string, orderid openapi_types.UUID, params *CancelOrderParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/orders/%s:cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListWebhooksRequest generates requests for ListWebhooks
+func NewListWebhooksRequest(server string, params *ListWebhooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, params *CreateWebhookParams, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, params *CreateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, webhookid openapi_types.UUID, params *DeleteWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookid", runtime.ParamLocationPath, webhookid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, webhookid openapi_types.UUID, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookid", runtime.ParamLocationPath, webhookid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReplayWebhookDeliveriesRequest generates requests for ReplayWebhookDeliveries
+func NewReplayWebhookDeliveriesRequest(server string, webhookid openapi_types.UUID, params *ReplayWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookid", runtime.ParamLocationPath, webhookid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhooks/%s:replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListOrdersV2Request generates requests for ListOrdersV2
+func NewListOrdersV2Request(server string, params *ListOrdersV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CustomerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "customerId", runtime.ParamLocationQuery, *params.CustomerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderedFrom", runtime.ParamLocationQuery, *params.OrderedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderedBefore", runtime.ParamLocationQuery, *params.OrderedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPlaceOrderV2Request calls the generic PlaceOrderV2 builder with application/json body
+func NewPlaceOrderV2Request(server string, params *PlaceOrderV2Params, body PlaceOrderV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPlaceOrderV2RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPlaceOrderV2RequestWithBody generates requests for PlaceOrderV2 with any type of body
+func NewPlaceOrderV2RequestWithBody(server string, params *PlaceOrderV2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IdempotencyKey != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetOrderV2Request generates requests for GetOrderV2
+func NewGetOrderV2Request(server string, orderid openapi_types.UUID, params *GetOrderV2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfNoneMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCancelOrderV2Request calls the generic CancelOrderV2 builder with application/json body
+func NewCancelOrderV2Request(server string, orderid openapi_types.UUID, params *CancelOrderV2Params, body CancelOrderV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCancelOrderV2RequestWithBody(server, orderid, params, "application/json", bodyReader)
+}
+
+// NewCancelOrderV2RequestWithBody generates requests for CancelOrderV2 with any type of body
+func NewCancelOrderV2RequestWithBody(server string, orderid openapi_types.UUID, params *CancelOrderV2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders/%s:cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewHoldOrderRequest calls the generic HoldOrder builder with application/json body
+func NewHoldOrderRequest(server string, orderid openapi_types.UUID, params *HoldOrderParams, body HoldOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHoldOrderRequestWithBody(server, orderid, params, "application/json", bodyReader)
+}
+
+// NewHoldOrderRequestWithBody generates requests for HoldOrder with any type of body
+func NewHoldOrderRequestWithBody(server string, orderid openapi_types.UUID, params *HoldOrderParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders/%s:hold", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSetOrderStatusRequest calls the generic SetOrderStatus builder with application/json body
+func NewSetOrderStatusRequest(server string, orderid openapi_types.UUID, params *SetOrderStatusParams, body SetOrderStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetOrderStatusRequestWithBody(server, orderid, params, "application/json", bodyReader)
+}
+
+// NewSetOrderStatusRequestWithBody generates requests for SetOrderStatus with any type of body
+func NewSetOrderStatusRequestWithBody(server string, orderid openapi_types.UUID, params *SetOrderStatusParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orderid", runtime.ParamLocationPath, orderid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/orders/%s:setStatus", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Userid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userid", runtime.ParamLocationQuery, *params.Userid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XTenantID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Tenant-ID", runtime.ParamLocationHeader, *params.XTenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Tenant-ID", headerParam0)
+		}
+
+		if params.XRequestID != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, *params.XRequestID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Request-ID", headerParam1)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *RawClient) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *RawClient) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListOrdersWithResponse request
+	ListOrdersWithResponse(ctx context.Context, params *ListOrdersParams, reqEditors ...RequestEditorFn) (*ListOrdersResponse, error)
+
+	// PlaceOrderWithBodyWithResponse request with any body
+	PlaceOrderWithBodyWithResponse(ctx context.Context, params *PlaceOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceOrderResponse, error)
+
+	PlaceOrderWithResponse(ctx context.Context, params *PlaceOrderParams, body PlaceOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceOrderResponse, error)
+
+	// GetOrderWithResponse request
+	GetOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderParams, reqEditors ...RequestEditorFn) (*GetOrderResponse, error)
+
+	// GetOrderEventsWithResponse request
+	GetOrderEventsWithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderEventsParams, reqEditors ...RequestEditorFn) (*GetOrderEventsResponse, error)
+
+	// CancelOrderWithBodyWithResponse request with any body
+	CancelOrderWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelOrderResponse, error)
+
+	CancelOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, body CancelOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelOrderResponse, error)
+
+	// ListWebhooksWithResponse request
+	ListWebhooksWithResponse(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// ReplayWebhookDeliveriesWithResponse request
+	ReplayWebhookDeliveriesWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *ReplayWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ReplayWebhookDeliveriesResponse, error)
+
+	// ListOrdersV2WithResponse request
+	ListOrdersV2WithResponse(ctx context.Context, params *ListOrdersV2Params, reqEditors ...RequestEditorFn) (*ListOrdersV2Response, error)
+
+	// PlaceOrderV2WithBodyWithResponse request with any body
+	PlaceOrderV2WithBodyWithResponse(ctx context.Context, params *PlaceOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceOrderV2Response, error)
+
+	PlaceOrderV2WithResponse(ctx context.Context, params *PlaceOrderV2Params, body PlaceOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceOrderV2Response, error)
+
+	// GetOrderV2WithResponse request
+	GetOrderV2WithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderV2Params, reqEditors ...RequestEditorFn) (*GetOrderV2Response, error)
+
+	// CancelOrderV2WithBodyWithResponse request with any body
+	CancelOrderV2WithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelOrderV2Response, error)
+
+	CancelOrderV2WithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, body CancelOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CancelOrderV2Response, error)
+
+	// HoldOrderWithBodyWithResponse request with any body
+	HoldOrderWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HoldOrderResponse, error)
+
+	HoldOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, body HoldOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*HoldOrderResponse, error)
+
+	// SetOrderStatusWithBodyWithResponse request with any body
+	SetOrderStatusWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrderStatusResponse, error)
+
+	SetOrderStatusWithResponse(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, body SetOrderStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrderStatusResponse, error)
+}
+
+type ListOrdersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderSummaryList
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrdersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrdersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlaceOrderResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderSummary
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON422                   *Error
+	ApplicationproblemJSON422 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PlaceOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlaceOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrderResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrderEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrderEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrderEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelOrderResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Order
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookList
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreatedWebhook
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookDeliveryList
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplayWebhookDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookDeliveryList
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplayWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplayWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrdersV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderSummaryListV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrdersV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrdersV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlaceOrderV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderSummaryV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON422                   *Error
+	ApplicationproblemJSON422 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PlaceOrderV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlaceOrderV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrderV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrderV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrderV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelOrderV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelOrderV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelOrderV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HoldOrderResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r HoldOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HoldOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetOrderStatusResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OrderV2
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON401                   *Error
+	ApplicationproblemJSON401 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+	JSON500                   *Error
+	ApplicationproblemJSON500 *Problem
+	JSON503                   *Error
+	ApplicationproblemJSON503 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetOrderStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetOrderStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListOrdersWithResponse request returning *ListOrdersResponse
+func (c *ClientWithResponses) ListOrdersWithResponse(ctx context.Context, params *ListOrdersParams, reqEditors ...RequestEditorFn) (*ListOrdersResponse, error) {
+	rsp, err := c.ListOrders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrdersResponse(rsp)
+}
+
+// PlaceOrderWithBodyWithResponse request with arbitrary body returning *PlaceOrderResponse
+func (c *ClientWithResponses) PlaceOrderWithBodyWithResponse(ctx context.Context, params *PlaceOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceOrderResponse, error) {
+	rsp, err := c.PlaceOrderWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceOrderResponse(rsp)
+}
+
+func (c *ClientWithResponses) PlaceOrderWithResponse(ctx context.Context, params *PlaceOrderParams, body PlaceOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceOrderResponse, error) {
+	rsp, err := c.PlaceOrder(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceOrderResponse(rsp)
+}
+
+// GetOrderWithResponse request returning *GetOrderResponse
+func (c *ClientWithResponses) GetOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderParams, reqEditors ...RequestEditorFn) (*GetOrderResponse, error) {
+	rsp, err := c.GetOrder(ctx, orderid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrderResponse(rsp)
+}
+
+// GetOrderEventsWithResponse request returning *GetOrderEventsResponse
+func (c *ClientWithResponses) GetOrderEventsWithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderEventsParams, reqEditors ...RequestEditorFn) (*GetOrderEventsResponse, error) {
+	rsp, err := c.GetOrderEvents(ctx, orderid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrderEventsResponse(rsp)
+}
+
+// CancelOrderWithBodyWithResponse request with arbitrary body returning *CancelOrderResponse
+func (c *ClientWithResponses) CancelOrderWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelOrderResponse, error) {
+	rsp, err := c.CancelOrderWithBody(ctx, orderid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelOrderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CancelOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderParams, body CancelOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CancelOrderResponse, error) {
+	rsp, err := c.CancelOrder(ctx, orderid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelOrderResponse(rsp)
+}
+
+// ListWebhooksWithResponse request returning *ListWebhooksResponse
+func (c *ClientWithResponses) ListWebhooksWithResponse(ctx context.Context, params *ListWebhooksParams, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error) {
+	rsp, err := c.ListWebhooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, webhookid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, webhookid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// ReplayWebhookDeliveriesWithResponse request returning *ReplayWebhookDeliveriesResponse
+func (c *ClientWithResponses) ReplayWebhookDeliveriesWithResponse(ctx context.Context, webhookid openapi_types.UUID, params *ReplayWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ReplayWebhookDeliveriesResponse, error) {
+	rsp, err := c.ReplayWebhookDeliveries(ctx, webhookid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayWebhookDeliveriesResponse(rsp)
+}
+
+// ListOrdersV2WithResponse request returning *ListOrdersV2Response
+func (c *ClientWithResponses) ListOrdersV2WithResponse(ctx context.Context, params *ListOrdersV2Params, reqEditors ...RequestEditorFn) (*ListOrdersV2Response, error) {
+	rsp, err := c.ListOrdersV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrdersV2Response(rsp)
+}
+
+// PlaceOrderV2WithBodyWithResponse request with arbitrary body returning *PlaceOrderV2Response
+func (c *ClientWithResponses) PlaceOrderV2WithBodyWithResponse(ctx context.Context, params *PlaceOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceOrderV2Response, error) {
+	rsp, err := c.PlaceOrderV2WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceOrderV2Response(rsp)
+}
+
+func (c *ClientWithResponses) PlaceOrderV2WithResponse(ctx context.Context, params *PlaceOrderV2Params, body PlaceOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceOrderV2Response, error) {
+	rsp, err := c.PlaceOrderV2(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceOrderV2Response(rsp)
+}
+
+// GetOrderV2WithResponse request returning *GetOrderV2Response
+func (c *ClientWithResponses) GetOrderV2WithResponse(ctx context.Context, orderid openapi_types.UUID, params *GetOrderV2Params, reqEditors ...RequestEditorFn) (*GetOrderV2Response, error) {
+	rsp, err := c.GetOrderV2(ctx, orderid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrderV2Response(rsp)
+}
+
+// CancelOrderV2WithBodyWithResponse request with arbitrary body returning *CancelOrderV2Response
+func (c *ClientWithResponses) CancelOrderV2WithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CancelOrderV2Response, error) {
+	rsp, err := c.CancelOrderV2WithBody(ctx, orderid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelOrderV2Response(rsp)
+}
+
+func (c *ClientWithResponses) CancelOrderV2WithResponse(ctx context.Context, orderid openapi_types.UUID, params *CancelOrderV2Params, body CancelOrderV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CancelOrderV2Response, error) {
+	rsp, err := c.CancelOrderV2(ctx, orderid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelOrderV2Response(rsp)
+}
+
+// HoldOrderWithBodyWithResponse request with arbitrary body returning *HoldOrderResponse
+func (c *ClientWithResponses) HoldOrderWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HoldOrderResponse, error) {
+	rsp, err := c.HoldOrderWithBody(ctx, orderid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHoldOrderResponse(rsp)
+}
+
+func (c *ClientWithResponses) HoldOrderWithResponse(ctx context.Context, orderid openapi_types.UUID, params *HoldOrderParams, body HoldOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*HoldOrderResponse, error) {
+	rsp, err := c.HoldOrder(ctx, orderid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHoldOrderResponse(rsp)
+}
+
+// SetOrderStatusWithBodyWithResponse request with arbitrary body returning *SetOrderStatusResponse
+func (c *ClientWithResponses) SetOrderStatusWithBodyWithResponse(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrderStatusResponse, error) {
+	rsp, err := c.SetOrderStatusWithBody(ctx, orderid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrderStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetOrderStatusWithResponse(ctx context.Context, orderid openapi_types.UUID, params *SetOrderStatusParams, body SetOrderStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrderStatusResponse, error) {
+	rsp, err := c.SetOrderStatus(ctx, orderid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrderStatusResponse(rsp)
+}
+
+// ParseListOrdersResponse parses an HTTP response from a ListOrdersWithResponse call
+func ParseListOrdersResponse(rsp *http.Response) (*ListOrdersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrdersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderSummaryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePlaceOrderResponse parses an HTTP response from a PlaceOrderWithResponse call
+func ParsePlaceOrderResponse(rsp *http.Response) (*PlaceOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PlaceOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrderResponse parses an HTTP response from a GetOrderWithResponse call
+func ParseGetOrderResponse(rsp *http.Response) (*GetOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrderEventsResponse parses an HTTP response from a GetOrderEventsWithResponse call
+func ParseGetOrderEventsResponse(rsp *http.Response) (*GetOrderEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrderEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelOrderResponse parses an HTTP response from a CancelOrderWithResponse call
+func ParseCancelOrderResponse(rsp *http.Response) (*CancelOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Order
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhooksResponse parses an HTTP response from a ListWebhooksWithResponse call
+func ParseListWebhooksResponse(rsp *http.Response) (*ListWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedWebhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayWebhookDeliveriesResponse parses an HTTP response from a ReplayWebhookDeliveriesWithResponse call
+func ParseReplayWebhookDeliveriesResponse(rsp *http.Response) (*ReplayWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrdersV2Response parses an HTTP response from a ListOrdersV2WithResponse call
+func ParseListOrdersV2Response(rsp *http.Response) (*ListOrdersV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrdersV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderSummaryListV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePlaceOrderV2Response parses an HTTP response from a PlaceOrderV2WithResponse call
+func ParsePlaceOrderV2Response(rsp *http.Response) (*PlaceOrderV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PlaceOrderV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderSummaryV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrderV2Response parses an HTTP response from a GetOrderV2WithResponse call
+func ParseGetOrderV2Response(rsp *http.Response) (*GetOrderV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrderV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelOrderV2Response parses an HTTP response from a CancelOrderV2WithResponse call
+func ParseCancelOrderV2Response(rsp *http.Response) (*CancelOrderV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelOrderV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHoldOrderResponse parses an HTTP response from a HoldOrderWithResponse call
+func ParseHoldOrderResponse(rsp *http.Response) (*HoldOrderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HoldOrderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetOrderStatusResponse parses an HTTP response from a SetOrderStatusWithResponse call
+func ParseSetOrderStatusResponse(rsp *http.Response) (*SetOrderStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetOrderStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrderV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,3 @@
+package ordersclient
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen@v1.13.4 -config client.cfg.yaml ../../../../rest-api-spec.yaml
@@ -0,0 +1,171 @@
+// Package ordersclient is a typed Go client for version 2 of the orders REST API. Run go generate
+// after changing the spec.
+package ordersclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const problemContentType = "application/problem+json"
+
+// APIError is returned for any response from the API other than a success.
+type APIError struct {
+	StatusCode int
+	Message    string
+	// InvalidParams lists every input the server rejected, for a 400 response.
+	InvalidParams []InvalidParam
+	// RetryAfter is how long the server asked to wait before retrying, for a 429 or 503 response.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("orders API returned %d: %s", e.StatusCode, e.Message)
+}
+
+type Client struct {
+	client *ClientWithResponses
+}
+
+// New creates a client for the API hosted at server, e.g. "http://localhost:8080". The options
+// are passed to the generated client, so WithHTTPClient can be used to set timeouts.
+func New(server string, opts ...ClientOption) (*Client, error) {
+	opts = append(opts, WithRequestEditorFn(acceptProblems))
+	client, err := NewClientWithResponses(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{client: client}, nil
+}
+
+// WithBearerToken authenticates every request with a token from the function. It is called for
+// each request, so that it can return a new token once the old one expires.
+func WithBearerToken(token func(ctx context.Context) (string, error)) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		bearer, err := token(ctx)
+		if err != nil {
+			return fmt.Errorf("getting bearer token: %w", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		return nil
+	})
+}
+
+// WithUserID makes every request as the user, for a server started in development mode that
+// takes the user from the userid query parameter rather than a bearer token.
+func WithUserID(userID uuid.UUID) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set("userid", userID.String())
+		req.URL.RawQuery = query.Encode()
+		return nil
+	})
+}
+
+// WithTenantID makes every request for the tenant, for a server started in development mode.
+func WithTenantID(tenantID string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Tenant-ID", tenantID)
+		return nil
+	})
+}
+
+// ListOrders returns a page of the customer's orders. If the returned list has a NextPageToken, it
+// can be set as the PageToken of params to get the next page.
+func (c *Client) ListOrders(ctx context.Context, params ListOrdersV2Params) (OrderSummaryListV2, error) {
+	resp, err := c.client.ListOrdersV2WithResponse(ctx, &params)
+	if err != nil {
+		return OrderSummaryListV2{}, err
+	}
+
+	if resp.JSON200 == nil {
+		return OrderSummaryListV2{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// PlaceOrder places an order for the customer. A non-empty idempotencyKey makes it safe to retry.
+func (c *Client) PlaceOrder(ctx context.Context, req PlaceOrderRequest, idempotencyKey string) (OrderSummaryV2, error) {
+	params := &PlaceOrderV2Params{}
+	if idempotencyKey != "" {
+		params.IdempotencyKey = &idempotencyKey
+	}
+
+	resp, err := c.client.PlaceOrderV2WithResponse(ctx, params, req)
+	if err != nil {
+		return OrderSummaryV2{}, err
+	}
+
+	if resp.JSON200 == nil {
+		return OrderSummaryV2{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (c *Client) GetOrder(ctx context.Context, orderID uuid.UUID) (OrderV2, error) {
+	resp, err := c.client.GetOrderV2WithResponse(ctx, orderID, &GetOrderV2Params{})
+	if err != nil {
+		return OrderV2{}, err
+	}
+
+	if resp.JSON200 == nil {
+		return OrderV2{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	return *resp.JSON200, nil
+}
+
+// acceptProblems asks for errors as RFC 7807 problems, as they list every invalid input.
+func acceptProblems(_ context.Context, req *http.Request) error {
+	req.Header.Set("Accept", "application/json, "+problemContentType)
+	return nil
+}
+
+// newAPIError reads the error from a response that was not a success, whether the server sent it
+// as a problem or as the older Error model.
+func newAPIError(resp *http.Response, body []byte) error {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+	}
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		apiErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == problemContentType {
+		var problem Problem
+		if err := json.Unmarshal(body, &problem); err != nil {
+			return apiErr
+		}
+
+		if problem.Detail != nil {
+			apiErr.Message = *problem.Detail
+		}
+
+		if problem.InvalidParams != nil {
+			apiErr.InvalidParams = *problem.InvalidParams
+		}
+
+		return apiErr
+	}
+
+	var errBody Error
+	if err := json.Unmarshal(body, &errBody); err == nil && errBody.Message != "" {
+		apiErr.Message = errBody.Message
+	}
+
+	return apiErr
+}
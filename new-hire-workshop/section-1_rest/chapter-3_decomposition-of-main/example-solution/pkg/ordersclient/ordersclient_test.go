@@ -0,0 +1,155 @@
+package ordersclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.hpe.com/cloud/go-gadgets/x/logging"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/authz"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/idempotency"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/orders"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/ratelimit"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/rest/api"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/internal/webhooks"
+	"github.hpe.com/nanda-sahu/ecommerce-workshop/chapter-3_decomposition-of-main/pkg/ordersclient"
+)
+
+func TestClientRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	customerID := uuid.New()
+	client, err := ordersclient.New(newServer(t).URL, ordersclient.WithUserID(customerID), ordersclient.WithTenantID("tenant"))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	req := ordersclient.PlaceOrderRequest{
+		ProductId: uuid.New(),
+		Address:   "1 Longdown Avenue",
+		PaymentId: uuid.New(),
+	}
+	first, err := client.PlaceOrder(ctx, req, "")
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+	if first.CustomerId != customerID || first.ProductId != req.ProductId || first.Status != ordersclient.OrderStatusV2Placed {
+		t.Errorf("placed order %+v, want a placed order of product %s for customer %s", first, req.ProductId, customerID)
+	}
+
+	// Placing an order again with the same idempotency key returns the order it placed the first
+	// time.
+	key := uuid.NewString()
+	second, err := client.PlaceOrder(ctx, req, key)
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+	retried, err := client.PlaceOrder(ctx, req, key)
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error for a retry: %v", err)
+	}
+	if retried.OrderId != second.OrderId {
+		t.Errorf("retry placed order %s, want the original order %s", retried.OrderId, second.OrderId)
+	}
+
+	order, err := client.GetOrder(ctx, first.OrderId)
+	if err != nil {
+		t.Fatalf("GetOrder returned error: %v", err)
+	}
+	if order.OrderId != first.OrderId || order.Address != req.Address || len(order.DeliveryEntries) != 1 {
+		t.Errorf("got order %+v, want the first placed order with one delivery entry", order)
+	}
+
+	var listed []uuid.UUID
+	limit := 1
+	params := ordersclient.ListOrdersV2Params{Limit: &limit}
+	for {
+		list, err := client.ListOrders(ctx, params)
+		if err != nil {
+			t.Fatalf("ListOrders returned error: %v", err)
+		}
+		for _, summary := range list.OrderSummaries {
+			listed = append(listed, summary.OrderId)
+		}
+
+		if list.NextPageToken == nil {
+			break
+		}
+		params.PageToken = list.NextPageToken
+	}
+	if len(listed) != 2 {
+		t.Errorf("listed orders %v, want the two placed orders", listed)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	ctx := context.Background()
+	client, err := ordersclient.New(newServer(t).URL, ordersclient.WithUserID(uuid.New()))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	var apiErr *ordersclient.APIError
+	if _, err := client.GetOrder(ctx, uuid.New()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("GetOrder of an unknown order returned %v, want a 404 APIError", err)
+	}
+
+	_, err = client.PlaceOrder(ctx, ordersclient.PlaceOrderRequest{ProductId: uuid.New(), PaymentId: uuid.New()}, "")
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || len(apiErr.InvalidParams) == 0 {
+		t.Errorf("PlaceOrder without an address returned %v, want a 400 APIError listing the invalid params", err)
+	}
+}
+
+// newServer starts the API with in-memory stores behind it, taking the user from the userid
+// query parameter. It is closed when the test ends.
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	ctx := context.Background()
+	logger := logging.NewNoopLogger()
+
+	spec, err := rest.ParseSpec(ctx, api.Spec)
+	if err != nil {
+		t.Fatalf("parsing API spec: %v", err)
+	}
+
+	validator, err := rest.NewRequestValidator(spec, logger)
+	if err != nil {
+		t.Fatalf("creating request validator: %v", err)
+	}
+
+	docs, err := rest.NewDocs(api.Spec, spec, logger)
+	if err != nil {
+		t.Fatalf("creating docs: %v", err)
+	}
+
+	limit := ratelimit.Limit{Requests: 10000, Per: time.Minute}
+	rateLimiter, err := rest.NewRateLimiter(ratelimit.NewBucketStore(), rest.RateLimits{Client: limit, User: limit}, spec, logger)
+	if err != nil {
+		t.Fatalf("creating rate limiter: %v", err)
+	}
+
+	feed := orders.NewFeed()
+	t.Cleanup(feed.Close)
+	orderStore := orders.NewFeedRepository(orders.NewOrderStore(), feed)
+
+	webhookService := webhooks.NewService(webhooks.NewStore(), rest.EncodeWebhookEvent, webhooks.RetryPolicy{
+		MaxAttempts: 1,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Second,
+	}, logger)
+	orderService := orders.NewService(orderStore, webhookService, logger)
+	authenticator := rest.NewQueryParamAuthenticator(logger)
+	idempotencyKeys := rest.NewIdempotency(idempotency.NewKeyStore(), time.Hour, logger)
+	policy := authz.NewPolicy(authz.NewLogAuditLog(logger))
+
+	router := rest.NewMux(orderStore, orderService, feed, webhookService, policy, validator, rateLimiter, authenticator, idempotencyKeys, docs, logger)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server
+}